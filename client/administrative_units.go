@@ -0,0 +1,84 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureADAdministrativeUnits https://learn.microsoft.com/en-us/graph/api/directory-list-administrativeunits?view=graph-rest-1.0
+func (s *azureClient) ListAzureADAdministrativeUnits(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.AdministrativeUnit] {
+	var (
+		out  = make(chan AzureResult[azure.AdministrativeUnit])
+		path = fmt.Sprintf("/%s/directory/administrativeUnits", constants.GraphApiVersion)
+	)
+
+	if params.Top == 0 {
+		params.Top = 999
+	}
+
+	go getAzureObjectList[azure.AdministrativeUnit](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureADAdministrativeUnitMembers https://learn.microsoft.com/en-us/graph/api/administrativeunit-list-members?view=graph-rest-1.0
+func (s *azureClient) ListAzureADAdministrativeUnitMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
+	var (
+		out  = make(chan AzureResult[json.RawMessage])
+		path = fmt.Sprintf("/%s/directory/administrativeUnits/%s/members", constants.GraphApiVersion, objectId)
+	)
+
+	if params.Top == 0 {
+		params.Top = 999
+	}
+
+	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureADAdministrativeUnitScopedRoleMembers https://learn.microsoft.com/en-us/graph/api/administrativeunit-list-scopedrolemembers?view=graph-rest-1.0
+func (s *azureClient) ListAzureADAdministrativeUnitScopedRoleMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[azure.ScopedRoleMembership] {
+	var (
+		out  = make(chan AzureResult[azure.ScopedRoleMembership])
+		path = fmt.Sprintf("/%s/directory/administrativeUnits/%s/scopedRoleMembers", constants.GraphApiVersion, objectId)
+	)
+
+	go getAzureObjectList[azure.ScopedRoleMembership](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureADDirectoryRoles https://learn.microsoft.com/en-us/graph/api/directoryrole-list?view=graph-rest-1.0
+func (s *azureClient) ListAzureADDirectoryRoles(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.DirectoryRole] {
+	var (
+		out  = make(chan AzureResult[azure.DirectoryRole])
+		path = fmt.Sprintf("/%s/directoryRoles", constants.GraphApiVersion)
+	)
+
+	go getAzureObjectList[azure.DirectoryRole](s.msgraph, ctx, path, params, out)
+
+	return out
+}
@@ -59,3 +59,15 @@ func (s *azureClient) ListAzureADAppOwners(ctx context.Context, objectId string,
 
 	return out
 }
+
+// ListAzureADAppFederatedIdentityCredentials https://learn.microsoft.com/en-us/graph/api/application-list-federatedidentitycredentials?view=graph-rest-1.0
+func (s *azureClient) ListAzureADAppFederatedIdentityCredentials(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[azure.FederatedIdentityCredential] {
+	var (
+		out  = make(chan AzureResult[azure.FederatedIdentityCredential])
+		path = fmt.Sprintf("/%s/applications/%s/federatedIdentityCredentials", constants.GraphApiVersion, objectId)
+	)
+
+	go getAzureObjectList[azure.FederatedIdentityCredential](s.msgraph, ctx, path, params, out)
+
+	return out
+}
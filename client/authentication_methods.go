@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureADUserRegistrationDetails https://learn.microsoft.com/en-us/graph/api/authenticationmethodsroot-list-userregistrationdetails?view=graph-rest-1.0
+// This endpoint requires the AuditLog.Read.All permission and a Microsoft Entra ID P1 or P2 license
+func (s *azureClient) ListAzureADUserRegistrationDetails(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.UserRegistrationDetails] {
+	var (
+		out  = make(chan AzureResult[azure.UserRegistrationDetails])
+		path = fmt.Sprintf("/%s/reports/authenticationMethods/userRegistrationDetails", constants.GraphApiVersion)
+	)
+
+	go getAzureObjectList[azure.UserRegistrationDetails](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureADUserAuthenticationMethods https://learn.microsoft.com/en-us/graph/api/authentication-list-methods?view=graph-rest-1.0
+// This endpoint requires the UserAuthenticationMethod.Read.All permission
+func (s *azureClient) ListAzureADUserAuthenticationMethods(ctx context.Context, userId string, params query.GraphParams) <-chan AzureResult[azure.AuthenticationMethod] {
+	var (
+		out  = make(chan AzureResult[azure.AuthenticationMethod])
+		path = fmt.Sprintf("/%s/users/%s/authentication/methods", constants.GraphApiVersion, userId)
+	)
+
+	go getAzureObjectList[azure.AuthenticationMethod](s.msgraph, ctx, path, params, out)
+
+	return out
+}
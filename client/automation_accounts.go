@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 )
 
@@ -37,3 +38,69 @@ func (s *azureClient) ListAzureAutomationAccounts(ctx context.Context, subscript
 
 	return out
 }
+
+// ListAzureAutomationRunbooks https://learn.microsoft.com/en-us/rest/api/automation/runbook/list-by-automation-account?view=rest-automation-2023-11-01
+func (s *azureClient) ListAzureAutomationRunbooks(ctx context.Context, automationAccountId string, params query.RMParams) <-chan AzureResult[azure.AutomationRunbook] {
+	var (
+		out  = make(chan AzureResult[azure.AutomationRunbook])
+		path = fmt.Sprintf("%s/runbooks", automationAccountId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2023-11-01"
+	}
+
+	go getAzureObjectList[azure.AutomationRunbook](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureAutomationHybridWorkerGroups https://learn.microsoft.com/en-us/rest/api/automation/hybrid-runbook-worker-group/list-by-automation-account?view=rest-automation-2023-11-01
+func (s *azureClient) ListAzureAutomationHybridWorkerGroups(ctx context.Context, automationAccountId string, params query.RMParams) <-chan AzureResult[azure.AutomationHybridWorkerGroup] {
+	var (
+		out  = make(chan AzureResult[azure.AutomationHybridWorkerGroup])
+		path = fmt.Sprintf("%s/hybridRunbookWorkerGroups", automationAccountId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2023-11-01"
+	}
+
+	go getAzureObjectList[azure.AutomationHybridWorkerGroup](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureAutomationHybridWorkers https://learn.microsoft.com/en-us/rest/api/automation/hybrid-runbook-workers/list-by-hybrid-runbook-worker-group?view=rest-automation-2023-11-01
+func (s *azureClient) ListAzureAutomationHybridWorkers(ctx context.Context, hybridWorkerGroupId string, params query.RMParams) <-chan AzureResult[azure.AutomationHybridWorker] {
+	var (
+		out  = make(chan AzureResult[azure.AutomationHybridWorker])
+		path = fmt.Sprintf("%s/hybridRunbookWorkers", hybridWorkerGroupId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2023-11-01"
+	}
+
+	go getAzureObjectList[azure.AutomationHybridWorker](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureAutomationAssets lists the credentials, certificates, connections or variables of an automation account.
+// See https://learn.microsoft.com/en-us/rest/api/automation/credential/list-by-automation-account?view=rest-automation-2023-11-01
+// and its sibling operations.
+func (s *azureClient) ListAzureAutomationAssets(ctx context.Context, automationAccountId string, assetType enums.AutomationAssetType, params query.RMParams) <-chan AzureResult[azure.AutomationAsset] {
+	var (
+		out  = make(chan AzureResult[azure.AutomationAsset])
+		path = fmt.Sprintf("%s/%s", automationAccountId, assetType)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2023-11-01"
+	}
+
+	go getAzureObjectList[azure.AutomationAsset](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -25,10 +25,12 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 
 	"github.com/bloodhoundad/azurehound/v2/client/config"
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
@@ -44,26 +46,29 @@ func NewClient(config config.Config) (AzureClient, error) {
 			if aud, err := rest.ParseAud(config.JWT); err != nil {
 				return nil, err
 			} else if aud == config.GraphUrl() {
-				return initClientViaGraph(msgraph, resourceManager)
+				return initClientViaGraph(config, msgraph, resourceManager)
 			} else if aud == config.ResourceManagerUrl() {
 				if body, err := rest.ParseBody(config.JWT); err != nil {
 					return nil, err
 				} else {
-					return initClientViaRM(msgraph, resourceManager, body["tid"])
+					return initClientViaRM(config, msgraph, resourceManager, body["tid"])
 				}
 			} else {
 				return nil, fmt.Errorf("error: invalid token audience")
 			}
 		} else {
-			return initClientViaGraph(msgraph, resourceManager)
+			return initClientViaGraph(config, msgraph, resourceManager)
 		}
 	}
 }
 
-func initClientViaRM(msgraph, resourceManager rest.RestClient, tid interface{}) (AzureClient, error) {
+func initClientViaRM(config config.Config, msgraph, resourceManager rest.RestClient, tid interface{}) (AzureClient, error) {
 	client := &azureClient{
+		config:          config,
 		msgraph:         msgraph,
 		resourceManager: resourceManager,
+		dataPlane:       make(map[string]rest.RestClient),
+		dataPlaneTokens: make(map[string]rest.RestClient),
 	}
 	if result, err := client.GetAzureADTenants(context.Background(), true); err != nil {
 		return nil, err
@@ -78,10 +83,13 @@ func initClientViaRM(msgraph, resourceManager rest.RestClient, tid interface{})
 	}
 }
 
-func initClientViaGraph(msgraph, resourceManager rest.RestClient) (AzureClient, error) {
+func initClientViaGraph(config config.Config, msgraph, resourceManager rest.RestClient) (AzureClient, error) {
 	client := &azureClient{
+		config:          config,
 		msgraph:         msgraph,
 		resourceManager: resourceManager,
+		dataPlane:       make(map[string]rest.RestClient),
+		dataPlaneTokens: make(map[string]rest.RestClient),
 	}
 	if org, err := client.GetAzureADOrganization(context.Background(), nil); err != nil {
 		return nil, err
@@ -169,9 +177,17 @@ func getAzureObjectList[T any](client rest.RestClient, ctx context.Context, path
 }
 
 type azureClient struct {
+	config          config.Config
 	msgraph         rest.RestClient
 	resourceManager rest.RestClient
 	tenant          azure.Tenant
+
+	// Rest clients for data plane hosts such as key vaults, keyed by host and created on first use
+	dataPlane      map[string]rest.RestClient
+	dataPlaneMutex sync.Mutex
+
+	// The first data plane client for each token audience, which later clients for that audience share a token with
+	dataPlaneTokens map[string]rest.RestClient
 }
 
 type AzureGraphClient interface {
@@ -179,43 +195,91 @@ type AzureGraphClient interface {
 
 	ListAzureADGroups(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Group]
 	ListAzureADGroupMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
+	ListAzureADGroupTransitiveMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
+	ListAzureADGroupTransitiveMemberGroups(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADGroupOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADGroups365(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Group365]
 	ListAzureADGroup365Members(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADGroup365Owners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADAppOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADApps(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Application]
+	ListAzureADAppFederatedIdentityCredentials(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[azure.FederatedIdentityCredential]
 	ListAzureADUsers(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.User]
 	ListAzureADRoleAssignments(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.UnifiedRoleAssignment]
 	ListAzureADRoles(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Role]
 	ListAzureADServicePrincipalOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureADServicePrincipals(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.ServicePrincipal]
+	ListAzureADServicePrincipalSignInActivities(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.ServicePrincipalSignInActivity]
 	ListAzureDeviceRegisteredOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
 	ListAzureDevices(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.Device]
+	ListAzureIntuneManagedDevices(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.IntuneManagedDevice]
+	ListAzureIntuneRoleAssignments(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.IntuneRoleAssignment]
+	ListAzureIntuneRoleScopeTags(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.IntuneRoleScopeTag]
 	ListAzureADAppRoleAssignments(ctx context.Context, servicePrincipalId string, params query.GraphParams) <-chan AzureResult[azure.AppRoleAssignment]
 	ListAzureADUsersInteractions(ctx context.Context, id string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
+	ListAzureADUserTransitiveMemberOf(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
+	ListAzureADConditionalAccessPolicies(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.ConditionalAccessPolicy]
+	ListAzureADNamedLocations(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.NamedLocation]
+	GetAzureADCrossTenantAccessPolicyDefault(ctx context.Context) (*azure.CrossTenantAccessPolicyConfiguration, error)
+	ListAzureADCrossTenantAccessPolicyPartners(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.CrossTenantAccessPolicyConfiguration]
+	GetAzureADAuthorizationPolicy(ctx context.Context) (*azure.AuthorizationPolicy, error)
+	ListAzureADUserRegistrationDetails(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.UserRegistrationDetails]
+	ListAzureADUserAuthenticationMethods(ctx context.Context, userId string, params query.GraphParams) <-chan AzureResult[azure.AuthenticationMethod]
+	ListAzureADOAuth2PermissionGrants(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.OAuth2PermissionGrant]
+	ListAzureADAdministrativeUnits(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.AdministrativeUnit]
+	ListAzureADAdministrativeUnitMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage]
+	ListAzureADAdministrativeUnitScopedRoleMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[azure.ScopedRoleMembership]
+	ListAzureADDirectoryRoles(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.DirectoryRole]
 }
 
 type AzureResourceManagerClient interface {
 	GetAzureADTenants(ctx context.Context, includeAllTenantCategories bool) (azure.TenantList, error)
 
 	ListRoleAssignmentsForResource(ctx context.Context, resourceId string, filter, tenantId string) <-chan AzureResult[azure.RoleAssignment]
+	ListAzureRoleAssignments(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RoleAssignment]
 	ListAzureADTenants(ctx context.Context, includeAllTenantCategories bool) <-chan AzureResult[azure.Tenant]
 	ListAzureContainerRegistries(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ContainerRegistry]
 	ListAzureWebApps(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.WebApp]
 	ListAzureManagedClusters(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.ManagedCluster]
 	ListAzureVMScaleSets(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.VMScaleSet]
 	ListAzureKeyVaults(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.KeyVault]
+	ListAzureKeyVaultSecrets(ctx context.Context, vaultUri string, params query.RMParams) <-chan AzureResult[azure.KeyVaultSecretItem]
+	ListAzureKeyVaultKeys(ctx context.Context, vaultUri string, params query.RMParams) <-chan AzureResult[azure.KeyVaultKeyItem]
+	ListAzureKeyVaultCertificates(ctx context.Context, vaultUri string, params query.RMParams) <-chan AzureResult[azure.KeyVaultCertificateItem]
 	ListAzureManagementGroups(ctx context.Context, skipToken string) <-chan AzureResult[azure.ManagementGroup]
 	ListAzureManagementGroupDescendants(ctx context.Context, groupId string, top int32) <-chan AzureResult[azure.DescendantInfo]
 	ListAzureResourceGroups(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.ResourceGroup]
 	ListAzureSubscriptions(ctx context.Context) <-chan AzureResult[azure.Subscription]
 	ListAzureVirtualMachines(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.VirtualMachine]
+	ListAzurePublicIPAddresses(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.PublicIPAddress]
+	ListAzureNetworkInterfaces(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.NetworkInterface]
+	ListAzureNetworkSecurityGroups(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.NetworkSecurityGroup]
+	ListAzureVirtualNetworks(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.VirtualNetwork]
+	ListAzureSqlServers(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.SqlServer]
+	ListAzureSqlServerFirewallRules(ctx context.Context, serverId string, params query.RMParams) <-chan AzureResult[azure.SqlServerFirewallRule]
+	ListAzureCosmosDBAccounts(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.CosmosDBAccount]
+	ListAzureDataFactories(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.DataFactory]
+	ListAzureSynapseWorkspaces(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.SynapseWorkspace]
+	ListAzureRegistrationAssignments(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RegistrationAssignment]
 	ListAzureStorageAccounts(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.StorageAccount]
 	ListAzureStorageContainers(ctx context.Context, subscriptionId string, resourceGroupName string, saName string, filter string, includeDeleted string, maxPageSize string) <-chan AzureResult[azure.StorageContainer]
 	ListAzureAutomationAccounts(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.AutomationAccount]
+	ListAzureAutomationRunbooks(ctx context.Context, automationAccountId string, params query.RMParams) <-chan AzureResult[azure.AutomationRunbook]
+	ListAzureAutomationHybridWorkerGroups(ctx context.Context, automationAccountId string, params query.RMParams) <-chan AzureResult[azure.AutomationHybridWorkerGroup]
+	ListAzureAutomationHybridWorkers(ctx context.Context, hybridWorkerGroupId string, params query.RMParams) <-chan AzureResult[azure.AutomationHybridWorker]
+	ListAzureAutomationAssets(ctx context.Context, automationAccountId string, assetType enums.AutomationAssetType, params query.RMParams) <-chan AzureResult[azure.AutomationAsset]
 	ListAzureLogicApps(ctx context.Context, subscriptionId string, filter string, top int32) <-chan AzureResult[azure.LogicApp]
+	ListAzureApiConnections(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.ApiConnection]
 	ListAzureFunctionApps(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.FunctionApp]
+	ListAzureWebAppSlots(ctx context.Context, siteId string, params query.RMParams) <-chan AzureResult[azure.WebApp]
+	ListAzureBasicPublishingCredentialsPolicies(ctx context.Context, siteId string, params query.RMParams) <-chan AzureResult[azure.PublishingCredentialsPolicy]
+	ListAzureUserAssignedIdentities(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.UserAssignedManagedIdentity]
+	ListAzureUserAssignedIdentityFederatedIdentityCredentials(ctx context.Context, identityId string) <-chan AzureResult[azure.ManagedIdentityFederatedIdentityCredential]
+	ListAzureRoleEligibilityScheduleInstances(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RoleEligibilityScheduleInstance]
+	ListAzureRoleManagementPolicyAssignments(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RoleManagementPolicyAssignment]
+	ListAzureRoleDefinitions(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RoleDefinition]
+	ListAzurePolicyAssignments(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.PolicyAssignment]
+	GetAzurePolicyDefinition(ctx context.Context, definitionId string) (azure.PolicyDefinition, error)
 }
 
 type AzureClient interface {
@@ -227,11 +291,54 @@ type AzureClient interface {
 	CloseIdleConnections()
 }
 
-func (s azureClient) TenantInfo() azure.Tenant {
+func (s *azureClient) TenantInfo() azure.Tenant {
 	return s.tenant
 }
 
-func (s azureClient) CloseIdleConnections() {
+func (s *azureClient) CloseIdleConnections() {
 	s.msgraph.CloseIdleConnections()
 	s.resourceManager.CloseIdleConnections()
+
+	s.dataPlaneMutex.Lock()
+	defer s.dataPlaneMutex.Unlock()
+	for _, client := range s.dataPlane {
+		client.CloseIdleConnections()
+	}
+}
+
+// dataPlaneClient returns the rest client for a data plane endpoint such as https://myvault.vault.azure.net, creating it
+// on first use. Endpoints with the same token audience, such as every key vault, share a single token.
+func (s *azureClient) dataPlaneClient(endpoint string) (rest.RestClient, error) {
+	if s.config.JWT != "" {
+		return nil, fmt.Errorf("data plane endpoints cannot be reached with a supplied JWT, which is issued for a single audience")
+	} else if u, err := url.Parse(endpoint); err != nil {
+		return nil, err
+	} else if u.Host == "" {
+		return nil, fmt.Errorf("invalid data plane endpoint: %s", endpoint)
+	} else {
+		var (
+			api      = url.URL{Scheme: "https", Host: u.Host}
+			audience = rest.TokenAudience(api)
+		)
+
+		s.dataPlaneMutex.Lock()
+		defer s.dataPlaneMutex.Unlock()
+
+		if client, ok := s.dataPlane[u.Host]; ok {
+			return client, nil
+		} else if parent, ok := s.dataPlaneTokens[audience.String()]; ok {
+			if client, err := rest.NewSharedRestClient(api.String(), parent); err != nil {
+				return nil, err
+			} else {
+				s.dataPlane[u.Host] = client
+				return client, nil
+			}
+		} else if client, err := rest.NewRestClient(api.String(), s.config); err != nil {
+			return nil, err
+		} else {
+			s.dataPlane[u.Host] = client
+			s.dataPlaneTokens[audience.String()] = client
+			return client, nil
+		}
+	}
 }
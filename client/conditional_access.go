@@ -0,0 +1,53 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureADConditionalAccessPolicies https://learn.microsoft.com/en-us/graph/api/conditionalaccessroot-list-policies?view=graph-rest-1.0
+// This endpoint requires the Policy.Read.All permission
+func (s *azureClient) ListAzureADConditionalAccessPolicies(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.ConditionalAccessPolicy] {
+	var (
+		out  = make(chan AzureResult[azure.ConditionalAccessPolicy])
+		path = fmt.Sprintf("/%s/identity/conditionalAccess/policies", constants.GraphApiVersion)
+	)
+
+	go getAzureObjectList[azure.ConditionalAccessPolicy](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureADNamedLocations https://learn.microsoft.com/en-us/graph/api/conditionalaccessroot-list-namedlocations?view=graph-rest-1.0
+// This endpoint requires the Policy.Read.All permission
+func (s *azureClient) ListAzureADNamedLocations(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.NamedLocation] {
+	var (
+		out  = make(chan AzureResult[azure.NamedLocation])
+		path = fmt.Sprintf("/%s/identity/conditionalAccess/namedLocations", constants.GraphApiVersion)
+	)
+
+	go getAzureObjectList[azure.NamedLocation](s.msgraph, ctx, path, params, out)
+
+	return out
+}
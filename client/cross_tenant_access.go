@@ -0,0 +1,73 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// GetAzureADCrossTenantAccessPolicyDefault https://learn.microsoft.com/en-us/graph/api/crosstenantaccesspolicyconfigurationdefault-get?view=graph-rest-1.0
+// This endpoint requires the Policy.Read.All permission
+func (s *azureClient) GetAzureADCrossTenantAccessPolicyDefault(ctx context.Context) (*azure.CrossTenantAccessPolicyConfiguration, error) {
+	var (
+		path     = fmt.Sprintf("/%s/policies/crossTenantAccessPolicy/default", constants.GraphApiVersion)
+		response azure.CrossTenantAccessPolicyConfiguration
+	)
+	if res, err := s.msgraph.Get(ctx, path, query.GraphParams{}, nil); err != nil {
+		return nil, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return nil, err
+	} else {
+		return &response, nil
+	}
+}
+
+// ListAzureADCrossTenantAccessPolicyPartners https://learn.microsoft.com/en-us/graph/api/crosstenantaccesspolicy-list-partners?view=graph-rest-1.0
+// This endpoint requires the Policy.Read.All permission
+func (s *azureClient) ListAzureADCrossTenantAccessPolicyPartners(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.CrossTenantAccessPolicyConfiguration] {
+	var (
+		out  = make(chan AzureResult[azure.CrossTenantAccessPolicyConfiguration])
+		path = fmt.Sprintf("/%s/policies/crossTenantAccessPolicy/partners", constants.GraphApiVersion)
+	)
+
+	go getAzureObjectList[azure.CrossTenantAccessPolicyConfiguration](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// GetAzureADAuthorizationPolicy https://learn.microsoft.com/en-us/graph/api/authorizationpolicy-get?view=graph-rest-1.0
+// This endpoint requires the Policy.Read.All permission
+func (s *azureClient) GetAzureADAuthorizationPolicy(ctx context.Context) (*azure.AuthorizationPolicy, error) {
+	var (
+		path     = fmt.Sprintf("/%s/policies/authorizationPolicy", constants.GraphApiVersion)
+		response azure.AuthorizationPolicy
+	)
+	if res, err := s.msgraph.Get(ctx, path, query.GraphParams{}, nil); err != nil {
+		return nil, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return nil, err
+	} else {
+		return &response, nil
+	}
+}
@@ -70,3 +70,28 @@ func (s *azureClient) ListAzureADGroupMembers(ctx context.Context, objectId stri
 
 	return out
 }
+
+// ListAzureADGroupTransitiveMembers https://learn.microsoft.com/en-us/graph/api/group-list-transitivemembers?view=graph-rest-1.0
+func (s *azureClient) ListAzureADGroupTransitiveMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
+	var (
+		out  = make(chan AzureResult[json.RawMessage])
+		path = fmt.Sprintf("/%s/groups/%s/transitiveMembers", constants.GraphApiVersion, objectId)
+	)
+
+	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureADGroupTransitiveMemberGroups https://learn.microsoft.com/en-us/graph/api/group-list-transitivemembers?view=graph-rest-1.0
+// Lists only the groups nested, directly or transitively, in the group
+func (s *azureClient) ListAzureADGroupTransitiveMemberGroups(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
+	var (
+		out  = make(chan AzureResult[json.RawMessage])
+		path = fmt.Sprintf("/%s/groups/%s/transitiveMembers/microsoft.graph.group", constants.GraphApiVersion, objectId)
+	)
+
+	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+
+	return out
+}
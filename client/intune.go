@@ -0,0 +1,66 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureIntuneManagedDevices https://learn.microsoft.com/en-us/graph/api/intune-devices-manageddevice-list?view=graph-rest-beta
+// This endpoint requires the DeviceManagementManagedDevices.Read.All permission
+func (s *azureClient) ListAzureIntuneManagedDevices(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.IntuneManagedDevice] {
+	var (
+		out  = make(chan AzureResult[azure.IntuneManagedDevice])
+		path = fmt.Sprintf("/%s/deviceManagement/managedDevices", constants.GraphApiBetaVersion)
+	)
+
+	go getAzureObjectList[azure.IntuneManagedDevice](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureIntuneRoleAssignments https://learn.microsoft.com/en-us/graph/api/intune-rbac-deviceandappmanagementroleassignment-list?view=graph-rest-beta
+// This endpoint requires the DeviceManagementRBAC.Read.All permission
+func (s *azureClient) ListAzureIntuneRoleAssignments(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.IntuneRoleAssignment] {
+	var (
+		out  = make(chan AzureResult[azure.IntuneRoleAssignment])
+		path = fmt.Sprintf("/%s/deviceManagement/roleAssignments", constants.GraphApiBetaVersion)
+	)
+
+	go getAzureObjectList[azure.IntuneRoleAssignment](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureIntuneRoleScopeTags https://learn.microsoft.com/en-us/graph/api/intune-rbac-rolescopetag-list?view=graph-rest-beta
+// This endpoint requires the DeviceManagementRBAC.Read.All permission
+func (s *azureClient) ListAzureIntuneRoleScopeTags(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.IntuneRoleScopeTag] {
+	var (
+		out  = make(chan AzureResult[azure.IntuneRoleScopeTag])
+		path = fmt.Sprintf("/%s/deviceManagement/roleScopeTags", constants.GraphApiBetaVersion)
+	)
+
+	go getAzureObjectList[azure.IntuneRoleScopeTag](s.msgraph, ctx, path, params, out)
+
+	return out
+}
@@ -23,6 +23,8 @@ import (
 
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
 )
 
 // ListAzureKeyVaults https://learn.microsoft.com/en-us/rest/api/keyvault/keyvault/vaults/list-by-subscription?view=rest-keyvault-keyvault-2019-09-01
@@ -40,3 +42,40 @@ func (s *azureClient) ListAzureKeyVaults(ctx context.Context, subscriptionId str
 
 	return out
 }
+
+// ListAzureKeyVaultSecrets https://learn.microsoft.com/en-us/rest/api/keyvault/secrets/get-secrets/get-secrets?view=rest-keyvault-secrets-7.4
+func (s *azureClient) ListAzureKeyVaultSecrets(ctx context.Context, vaultUri string, params query.RMParams) <-chan AzureResult[azure.KeyVaultSecretItem] {
+	out := make(chan AzureResult[azure.KeyVaultSecretItem])
+	go listKeyVaultItems(s, ctx, vaultUri, "/secrets", params, out)
+	return out
+}
+
+// ListAzureKeyVaultKeys https://learn.microsoft.com/en-us/rest/api/keyvault/keys/get-keys/get-keys?view=rest-keyvault-keys-7.4
+func (s *azureClient) ListAzureKeyVaultKeys(ctx context.Context, vaultUri string, params query.RMParams) <-chan AzureResult[azure.KeyVaultKeyItem] {
+	out := make(chan AzureResult[azure.KeyVaultKeyItem])
+	go listKeyVaultItems(s, ctx, vaultUri, "/keys", params, out)
+	return out
+}
+
+// ListAzureKeyVaultCertificates https://learn.microsoft.com/en-us/rest/api/keyvault/certificates/get-certificates/get-certificates?view=rest-keyvault-certificates-7.4
+func (s *azureClient) ListAzureKeyVaultCertificates(ctx context.Context, vaultUri string, params query.RMParams) <-chan AzureResult[azure.KeyVaultCertificateItem] {
+	out := make(chan AzureResult[azure.KeyVaultCertificateItem])
+	go listKeyVaultItems(s, ctx, vaultUri, "/certificates", params, out)
+	return out
+}
+
+// listKeyVaultItems lists a collection from a vault's data plane, which is served from the vault's own host rather
+// than from resource manager.
+func listKeyVaultItems[T any](s *azureClient, ctx context.Context, vaultUri string, path string, params query.RMParams, out chan AzureResult[T]) {
+	if params.ApiVersion == "" {
+		params.ApiVersion = "7.4"
+	}
+
+	if client, err := s.dataPlaneClient(vaultUri); err != nil {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+		_ = pipeline.Send(ctx.Done(), out, AzureResult[T]{Error: err})
+	} else {
+		getAzureObjectList[T](client, ctx, path, params, out)
+	}
+}
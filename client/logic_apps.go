@@ -37,3 +37,19 @@ func (s *azureClient) ListAzureLogicApps(ctx context.Context, subscriptionId str
 
 	return out
 }
+
+// ListAzureApiConnections https://learn.microsoft.com/en-us/rest/api/logic/connections
+func (s *azureClient) ListAzureApiConnections(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.ApiConnection] {
+	var (
+		out  = make(chan AzureResult[azure.ApiConnection])
+		path = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Web/connections", subscriptionId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2016-06-01"
+	}
+
+	go getAzureObjectList[azure.ApiConnection](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
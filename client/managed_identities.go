@@ -0,0 +1,52 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureUserAssignedIdentities https://learn.microsoft.com/en-us/rest/api/managedidentity/user-assigned-identities/list-by-subscription?view=rest-managedidentity-2023-01-31
+func (s *azureClient) ListAzureUserAssignedIdentities(ctx context.Context, subscriptionId string) <-chan AzureResult[azure.UserAssignedManagedIdentity] {
+	var (
+		out    = make(chan AzureResult[azure.UserAssignedManagedIdentity])
+		path   = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities", subscriptionId)
+		params = query.RMParams{ApiVersion: "2023-01-31"}
+	)
+
+	go getAzureObjectList[azure.UserAssignedManagedIdentity](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureUserAssignedIdentityFederatedIdentityCredentials https://learn.microsoft.com/en-us/rest/api/managedidentity/federated-identity-credentials/list?view=rest-managedidentity-2023-01-31
+func (s *azureClient) ListAzureUserAssignedIdentityFederatedIdentityCredentials(ctx context.Context, identityId string) <-chan AzureResult[azure.ManagedIdentityFederatedIdentityCredential] {
+	var (
+		out    = make(chan AzureResult[azure.ManagedIdentityFederatedIdentityCredential])
+		path   = fmt.Sprintf("%s/federatedIdentityCredentials", identityId)
+		params = query.RMParams{ApiVersion: "2023-01-31"}
+	)
+
+	go getAzureObjectList[azure.ManagedIdentityFederatedIdentityCredential](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -16,6 +16,7 @@ import (
 
 	client "github.com/bloodhoundad/azurehound/v2/client"
 	query "github.com/bloodhoundad/azurehound/v2/client/query"
+	enums "github.com/bloodhoundad/azurehound/v2/enums"
 	azure "github.com/bloodhoundad/azurehound/v2/models/azure"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -27,11 +28,6 @@ type MockAzureClient struct {
 	isgomock struct{}
 }
 
-// ListAzureADUsersInteractions implements client.AzureClient.
-func (m *MockAzureClient) ListAzureADUsersInteractions(ctx context.Context, id string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
-	panic("unimplemented")
-}
-
 // MockAzureClientMockRecorder is the mock recorder for MockAzureClient.
 type MockAzureClientMockRecorder struct {
 	mock *MockAzureClient
@@ -61,6 +57,36 @@ func (mr *MockAzureClientMockRecorder) CloseIdleConnections() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseIdleConnections", reflect.TypeOf((*MockAzureClient)(nil).CloseIdleConnections))
 }
 
+// GetAzureADAuthorizationPolicy mocks base method.
+func (m *MockAzureClient) GetAzureADAuthorizationPolicy(ctx context.Context) (*azure.AuthorizationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureADAuthorizationPolicy", ctx)
+	ret0, _ := ret[0].(*azure.AuthorizationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureADAuthorizationPolicy indicates an expected call of GetAzureADAuthorizationPolicy.
+func (mr *MockAzureClientMockRecorder) GetAzureADAuthorizationPolicy(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureADAuthorizationPolicy", reflect.TypeOf((*MockAzureClient)(nil).GetAzureADAuthorizationPolicy), ctx)
+}
+
+// GetAzureADCrossTenantAccessPolicyDefault mocks base method.
+func (m *MockAzureClient) GetAzureADCrossTenantAccessPolicyDefault(ctx context.Context) (*azure.CrossTenantAccessPolicyConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzureADCrossTenantAccessPolicyDefault", ctx)
+	ret0, _ := ret[0].(*azure.CrossTenantAccessPolicyConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzureADCrossTenantAccessPolicyDefault indicates an expected call of GetAzureADCrossTenantAccessPolicyDefault.
+func (mr *MockAzureClientMockRecorder) GetAzureADCrossTenantAccessPolicyDefault(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureADCrossTenantAccessPolicyDefault", reflect.TypeOf((*MockAzureClient)(nil).GetAzureADCrossTenantAccessPolicyDefault), ctx)
+}
+
 // GetAzureADOrganization mocks base method.
 func (m *MockAzureClient) GetAzureADOrganization(ctx context.Context, selectCols []string) (*azure.Organization, error) {
 	m.ctrl.T.Helper()
@@ -91,6 +117,77 @@ func (mr *MockAzureClientMockRecorder) GetAzureADTenants(ctx, includeAllTenantCa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzureADTenants", reflect.TypeOf((*MockAzureClient)(nil).GetAzureADTenants), ctx, includeAllTenantCategories)
 }
 
+// GetAzurePolicyDefinition mocks base method.
+func (m *MockAzureClient) GetAzurePolicyDefinition(ctx context.Context, definitionId string) (azure.PolicyDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAzurePolicyDefinition", ctx, definitionId)
+	ret0, _ := ret[0].(azure.PolicyDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAzurePolicyDefinition indicates an expected call of GetAzurePolicyDefinition.
+func (mr *MockAzureClientMockRecorder) GetAzurePolicyDefinition(ctx, definitionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAzurePolicyDefinition", reflect.TypeOf((*MockAzureClient)(nil).GetAzurePolicyDefinition), ctx, definitionId)
+}
+
+// ListAzureADAdministrativeUnitMembers mocks base method.
+func (m *MockAzureClient) ListAzureADAdministrativeUnitMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADAdministrativeUnitMembers", ctx, objectId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
+	return ret0
+}
+
+// ListAzureADAdministrativeUnitMembers indicates an expected call of ListAzureADAdministrativeUnitMembers.
+func (mr *MockAzureClientMockRecorder) ListAzureADAdministrativeUnitMembers(ctx, objectId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADAdministrativeUnitMembers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADAdministrativeUnitMembers), ctx, objectId, params)
+}
+
+// ListAzureADAdministrativeUnitScopedRoleMembers mocks base method.
+func (m *MockAzureClient) ListAzureADAdministrativeUnitScopedRoleMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[azure.ScopedRoleMembership] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADAdministrativeUnitScopedRoleMembers", ctx, objectId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ScopedRoleMembership])
+	return ret0
+}
+
+// ListAzureADAdministrativeUnitScopedRoleMembers indicates an expected call of ListAzureADAdministrativeUnitScopedRoleMembers.
+func (mr *MockAzureClientMockRecorder) ListAzureADAdministrativeUnitScopedRoleMembers(ctx, objectId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADAdministrativeUnitScopedRoleMembers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADAdministrativeUnitScopedRoleMembers), ctx, objectId, params)
+}
+
+// ListAzureADAdministrativeUnits mocks base method.
+func (m *MockAzureClient) ListAzureADAdministrativeUnits(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.AdministrativeUnit] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADAdministrativeUnits", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AdministrativeUnit])
+	return ret0
+}
+
+// ListAzureADAdministrativeUnits indicates an expected call of ListAzureADAdministrativeUnits.
+func (mr *MockAzureClientMockRecorder) ListAzureADAdministrativeUnits(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADAdministrativeUnits", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADAdministrativeUnits), ctx, params)
+}
+
+// ListAzureADAppFederatedIdentityCredentials mocks base method.
+func (m *MockAzureClient) ListAzureADAppFederatedIdentityCredentials(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[azure.FederatedIdentityCredential] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADAppFederatedIdentityCredentials", ctx, objectId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.FederatedIdentityCredential])
+	return ret0
+}
+
+// ListAzureADAppFederatedIdentityCredentials indicates an expected call of ListAzureADAppFederatedIdentityCredentials.
+func (mr *MockAzureClientMockRecorder) ListAzureADAppFederatedIdentityCredentials(ctx, objectId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADAppFederatedIdentityCredentials", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADAppFederatedIdentityCredentials), ctx, objectId, params)
+}
+
 // ListAzureADAppOwners mocks base method.
 func (m *MockAzureClient) ListAzureADAppOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
 	m.ctrl.T.Helper()
@@ -133,32 +230,102 @@ func (mr *MockAzureClientMockRecorder) ListAzureADApps(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADApps", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADApps), ctx, params)
 }
 
+// ListAzureADConditionalAccessPolicies mocks base method.
+func (m *MockAzureClient) ListAzureADConditionalAccessPolicies(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.ConditionalAccessPolicy] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADConditionalAccessPolicies", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ConditionalAccessPolicy])
+	return ret0
+}
+
+// ListAzureADConditionalAccessPolicies indicates an expected call of ListAzureADConditionalAccessPolicies.
+func (mr *MockAzureClientMockRecorder) ListAzureADConditionalAccessPolicies(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADConditionalAccessPolicies", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADConditionalAccessPolicies), ctx, params)
+}
+
+// ListAzureADCrossTenantAccessPolicyPartners mocks base method.
+func (m *MockAzureClient) ListAzureADCrossTenantAccessPolicyPartners(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.CrossTenantAccessPolicyConfiguration] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADCrossTenantAccessPolicyPartners", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.CrossTenantAccessPolicyConfiguration])
+	return ret0
+}
+
+// ListAzureADCrossTenantAccessPolicyPartners indicates an expected call of ListAzureADCrossTenantAccessPolicyPartners.
+func (mr *MockAzureClientMockRecorder) ListAzureADCrossTenantAccessPolicyPartners(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADCrossTenantAccessPolicyPartners", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADCrossTenantAccessPolicyPartners), ctx, params)
+}
+
+// ListAzureADDirectoryRoles mocks base method.
+func (m *MockAzureClient) ListAzureADDirectoryRoles(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.DirectoryRole] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADDirectoryRoles", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.DirectoryRole])
+	return ret0
+}
+
+// ListAzureADDirectoryRoles indicates an expected call of ListAzureADDirectoryRoles.
+func (mr *MockAzureClientMockRecorder) ListAzureADDirectoryRoles(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADDirectoryRoles", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADDirectoryRoles), ctx, params)
+}
+
 // ListAzureADGroup365Members mocks base method.
-func (m *MockAzureClient) ListAzureADGroup365Members(arg0 context.Context, arg1 string, arg2 query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
+func (m *MockAzureClient) ListAzureADGroup365Members(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAzureADGroup365Members", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListAzureADGroup365Members", ctx, objectId, params)
 	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
 	return ret0
 }
 
 // ListAzureADGroup365Members indicates an expected call of ListAzureADGroup365Members.
-func (mr *MockAzureClientMockRecorder) ListAzureADGroup365Members(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAzureClientMockRecorder) ListAzureADGroup365Members(ctx, objectId, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroup365Members", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroup365Members), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroup365Members", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroup365Members), ctx, objectId, params)
 }
 
 // ListAzureADGroup365Owners mocks base method.
-func (m *MockAzureClient) ListAzureADGroup365Owners(arg0 context.Context, arg1 string, arg2 query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
+func (m *MockAzureClient) ListAzureADGroup365Owners(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAzureADGroup365Owners", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListAzureADGroup365Owners", ctx, objectId, params)
 	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
 	return ret0
 }
 
 // ListAzureADGroup365Owners indicates an expected call of ListAzureADGroup365Owners.
-func (mr *MockAzureClientMockRecorder) ListAzureADGroup365Owners(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockAzureClientMockRecorder) ListAzureADGroup365Owners(ctx, objectId, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroup365Owners", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroup365Owners), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroup365Owners", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroup365Owners), ctx, objectId, params)
+}
+
+// ListAzureADGroupAssignmentScheduleInstances mocks base method.
+func (m *MockAzureClient) ListAzureADGroupAssignmentScheduleInstances(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.PrivilegedAccessGroupAssignmentScheduleInstance] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADGroupAssignmentScheduleInstances", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.PrivilegedAccessGroupAssignmentScheduleInstance])
+	return ret0
+}
+
+// ListAzureADGroupAssignmentScheduleInstances indicates an expected call of ListAzureADGroupAssignmentScheduleInstances.
+func (mr *MockAzureClientMockRecorder) ListAzureADGroupAssignmentScheduleInstances(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroupAssignmentScheduleInstances", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroupAssignmentScheduleInstances), ctx, params)
+}
+
+// ListAzureADGroupEligibilityScheduleInstances mocks base method.
+func (m *MockAzureClient) ListAzureADGroupEligibilityScheduleInstances(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADGroupEligibilityScheduleInstances", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance])
+	return ret0
+}
+
+// ListAzureADGroupEligibilityScheduleInstances indicates an expected call of ListAzureADGroupEligibilityScheduleInstances.
+func (mr *MockAzureClientMockRecorder) ListAzureADGroupEligibilityScheduleInstances(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroupEligibilityScheduleInstances", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroupEligibilityScheduleInstances), ctx, params)
 }
 
 // ListAzureADGroupMembers mocks base method.
@@ -189,6 +356,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureADGroupOwners(ctx, objectId, par
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroupOwners", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroupOwners), ctx, objectId, params)
 }
 
+// ListAzureADGroupTransitiveMemberGroups mocks base method.
+func (m *MockAzureClient) ListAzureADGroupTransitiveMemberGroups(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADGroupTransitiveMemberGroups", ctx, objectId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
+	return ret0
+}
+
+// ListAzureADGroupTransitiveMemberGroups indicates an expected call of ListAzureADGroupTransitiveMemberGroups.
+func (mr *MockAzureClientMockRecorder) ListAzureADGroupTransitiveMemberGroups(ctx, objectId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroupTransitiveMemberGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroupTransitiveMemberGroups), ctx, objectId, params)
+}
+
+// ListAzureADGroupTransitiveMembers mocks base method.
+func (m *MockAzureClient) ListAzureADGroupTransitiveMembers(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADGroupTransitiveMembers", ctx, objectId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
+	return ret0
+}
+
+// ListAzureADGroupTransitiveMembers indicates an expected call of ListAzureADGroupTransitiveMembers.
+func (mr *MockAzureClientMockRecorder) ListAzureADGroupTransitiveMembers(ctx, objectId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroupTransitiveMembers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroupTransitiveMembers), ctx, objectId, params)
+}
+
 // ListAzureADGroups mocks base method.
 func (m *MockAzureClient) ListAzureADGroups(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.Group] {
 	m.ctrl.T.Helper()
@@ -204,17 +399,45 @@ func (mr *MockAzureClientMockRecorder) ListAzureADGroups(ctx, params any) *gomoc
 }
 
 // ListAzureADGroups365 mocks base method.
-func (m *MockAzureClient) ListAzureADGroups365(arg0 context.Context, arg1 query.GraphParams) <-chan client.AzureResult[azure.Group365] {
+func (m *MockAzureClient) ListAzureADGroups365(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.Group365] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAzureADGroups365", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListAzureADGroups365", ctx, params)
 	ret0, _ := ret[0].(<-chan client.AzureResult[azure.Group365])
 	return ret0
 }
 
 // ListAzureADGroups365 indicates an expected call of ListAzureADGroups365.
-func (mr *MockAzureClientMockRecorder) ListAzureADGroups365(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockAzureClientMockRecorder) ListAzureADGroups365(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroups365", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroups365), ctx, params)
+}
+
+// ListAzureADNamedLocations mocks base method.
+func (m *MockAzureClient) ListAzureADNamedLocations(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.NamedLocation] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADNamedLocations", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.NamedLocation])
+	return ret0
+}
+
+// ListAzureADNamedLocations indicates an expected call of ListAzureADNamedLocations.
+func (mr *MockAzureClientMockRecorder) ListAzureADNamedLocations(ctx, params any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADGroups365", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADGroups365), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADNamedLocations", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADNamedLocations), ctx, params)
+}
+
+// ListAzureADOAuth2PermissionGrants mocks base method.
+func (m *MockAzureClient) ListAzureADOAuth2PermissionGrants(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.OAuth2PermissionGrant] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADOAuth2PermissionGrants", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.OAuth2PermissionGrant])
+	return ret0
+}
+
+// ListAzureADOAuth2PermissionGrants indicates an expected call of ListAzureADOAuth2PermissionGrants.
+func (mr *MockAzureClientMockRecorder) ListAzureADOAuth2PermissionGrants(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADOAuth2PermissionGrants", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADOAuth2PermissionGrants), ctx, params)
 }
 
 // ListAzureADRoleAssignments mocks base method.
@@ -259,6 +482,20 @@ func (mr *MockAzureClientMockRecorder) ListAzureADServicePrincipalOwners(ctx, ob
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADServicePrincipalOwners", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADServicePrincipalOwners), ctx, objectId, params)
 }
 
+// ListAzureADServicePrincipalSignInActivities mocks base method.
+func (m *MockAzureClient) ListAzureADServicePrincipalSignInActivities(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.ServicePrincipalSignInActivity] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADServicePrincipalSignInActivities", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ServicePrincipalSignInActivity])
+	return ret0
+}
+
+// ListAzureADServicePrincipalSignInActivities indicates an expected call of ListAzureADServicePrincipalSignInActivities.
+func (mr *MockAzureClientMockRecorder) ListAzureADServicePrincipalSignInActivities(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADServicePrincipalSignInActivities", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADServicePrincipalSignInActivities), ctx, params)
+}
+
 // ListAzureADServicePrincipals mocks base method.
 func (m *MockAzureClient) ListAzureADServicePrincipals(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.ServicePrincipal] {
 	m.ctrl.T.Helper()
@@ -287,6 +524,48 @@ func (mr *MockAzureClientMockRecorder) ListAzureADTenants(ctx, includeAllTenantC
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADTenants", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADTenants), ctx, includeAllTenantCategories)
 }
 
+// ListAzureADUserAuthenticationMethods mocks base method.
+func (m *MockAzureClient) ListAzureADUserAuthenticationMethods(ctx context.Context, userId string, params query.GraphParams) <-chan client.AzureResult[azure.AuthenticationMethod] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADUserAuthenticationMethods", ctx, userId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AuthenticationMethod])
+	return ret0
+}
+
+// ListAzureADUserAuthenticationMethods indicates an expected call of ListAzureADUserAuthenticationMethods.
+func (mr *MockAzureClientMockRecorder) ListAzureADUserAuthenticationMethods(ctx, userId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADUserAuthenticationMethods", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADUserAuthenticationMethods), ctx, userId, params)
+}
+
+// ListAzureADUserRegistrationDetails mocks base method.
+func (m *MockAzureClient) ListAzureADUserRegistrationDetails(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.UserRegistrationDetails] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADUserRegistrationDetails", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.UserRegistrationDetails])
+	return ret0
+}
+
+// ListAzureADUserRegistrationDetails indicates an expected call of ListAzureADUserRegistrationDetails.
+func (mr *MockAzureClientMockRecorder) ListAzureADUserRegistrationDetails(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADUserRegistrationDetails", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADUserRegistrationDetails), ctx, params)
+}
+
+// ListAzureADUserTransitiveMemberOf mocks base method.
+func (m *MockAzureClient) ListAzureADUserTransitiveMemberOf(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADUserTransitiveMemberOf", ctx, objectId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
+	return ret0
+}
+
+// ListAzureADUserTransitiveMemberOf indicates an expected call of ListAzureADUserTransitiveMemberOf.
+func (mr *MockAzureClientMockRecorder) ListAzureADUserTransitiveMemberOf(ctx, objectId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADUserTransitiveMemberOf", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADUserTransitiveMemberOf), ctx, objectId, params)
+}
+
 // ListAzureADUsers mocks base method.
 func (m *MockAzureClient) ListAzureADUsers(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.User] {
 	m.ctrl.T.Helper()
@@ -301,6 +580,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureADUsers(ctx, params any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADUsers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADUsers), ctx, params)
 }
 
+// ListAzureADUsersInteractions mocks base method.
+func (m *MockAzureClient) ListAzureADUsersInteractions(ctx context.Context, id string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureADUsersInteractions", ctx, id, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[json.RawMessage])
+	return ret0
+}
+
+// ListAzureADUsersInteractions indicates an expected call of ListAzureADUsersInteractions.
+func (mr *MockAzureClientMockRecorder) ListAzureADUsersInteractions(ctx, id, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureADUsersInteractions", reflect.TypeOf((*MockAzureClient)(nil).ListAzureADUsersInteractions), ctx, id, params)
+}
+
+// ListAzureApiConnections mocks base method.
+func (m *MockAzureClient) ListAzureApiConnections(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.ApiConnection] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureApiConnections", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ApiConnection])
+	return ret0
+}
+
+// ListAzureApiConnections indicates an expected call of ListAzureApiConnections.
+func (mr *MockAzureClientMockRecorder) ListAzureApiConnections(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureApiConnections", reflect.TypeOf((*MockAzureClient)(nil).ListAzureApiConnections), ctx, subscriptionId, params)
+}
+
 // ListAzureAutomationAccounts mocks base method.
 func (m *MockAzureClient) ListAzureAutomationAccounts(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.AutomationAccount] {
 	m.ctrl.T.Helper()
@@ -315,6 +622,76 @@ func (mr *MockAzureClientMockRecorder) ListAzureAutomationAccounts(ctx, subscrip
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationAccounts", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationAccounts), ctx, subscriptionId)
 }
 
+// ListAzureAutomationAssets mocks base method.
+func (m *MockAzureClient) ListAzureAutomationAssets(ctx context.Context, automationAccountId string, assetType enums.AutomationAssetType, params query.RMParams) <-chan client.AzureResult[azure.AutomationAsset] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationAssets", ctx, automationAccountId, assetType, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationAsset])
+	return ret0
+}
+
+// ListAzureAutomationAssets indicates an expected call of ListAzureAutomationAssets.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationAssets(ctx, automationAccountId, assetType, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationAssets", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationAssets), ctx, automationAccountId, assetType, params)
+}
+
+// ListAzureAutomationHybridWorkerGroups mocks base method.
+func (m *MockAzureClient) ListAzureAutomationHybridWorkerGroups(ctx context.Context, automationAccountId string, params query.RMParams) <-chan client.AzureResult[azure.AutomationHybridWorkerGroup] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationHybridWorkerGroups", ctx, automationAccountId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationHybridWorkerGroup])
+	return ret0
+}
+
+// ListAzureAutomationHybridWorkerGroups indicates an expected call of ListAzureAutomationHybridWorkerGroups.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationHybridWorkerGroups(ctx, automationAccountId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationHybridWorkerGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationHybridWorkerGroups), ctx, automationAccountId, params)
+}
+
+// ListAzureAutomationHybridWorkers mocks base method.
+func (m *MockAzureClient) ListAzureAutomationHybridWorkers(ctx context.Context, hybridWorkerGroupId string, params query.RMParams) <-chan client.AzureResult[azure.AutomationHybridWorker] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationHybridWorkers", ctx, hybridWorkerGroupId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationHybridWorker])
+	return ret0
+}
+
+// ListAzureAutomationHybridWorkers indicates an expected call of ListAzureAutomationHybridWorkers.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationHybridWorkers(ctx, hybridWorkerGroupId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationHybridWorkers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationHybridWorkers), ctx, hybridWorkerGroupId, params)
+}
+
+// ListAzureAutomationRunbooks mocks base method.
+func (m *MockAzureClient) ListAzureAutomationRunbooks(ctx context.Context, automationAccountId string, params query.RMParams) <-chan client.AzureResult[azure.AutomationRunbook] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureAutomationRunbooks", ctx, automationAccountId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.AutomationRunbook])
+	return ret0
+}
+
+// ListAzureAutomationRunbooks indicates an expected call of ListAzureAutomationRunbooks.
+func (mr *MockAzureClientMockRecorder) ListAzureAutomationRunbooks(ctx, automationAccountId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureAutomationRunbooks", reflect.TypeOf((*MockAzureClient)(nil).ListAzureAutomationRunbooks), ctx, automationAccountId, params)
+}
+
+// ListAzureBasicPublishingCredentialsPolicies mocks base method.
+func (m *MockAzureClient) ListAzureBasicPublishingCredentialsPolicies(ctx context.Context, siteId string, params query.RMParams) <-chan client.AzureResult[azure.PublishingCredentialsPolicy] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureBasicPublishingCredentialsPolicies", ctx, siteId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.PublishingCredentialsPolicy])
+	return ret0
+}
+
+// ListAzureBasicPublishingCredentialsPolicies indicates an expected call of ListAzureBasicPublishingCredentialsPolicies.
+func (mr *MockAzureClientMockRecorder) ListAzureBasicPublishingCredentialsPolicies(ctx, siteId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureBasicPublishingCredentialsPolicies", reflect.TypeOf((*MockAzureClient)(nil).ListAzureBasicPublishingCredentialsPolicies), ctx, siteId, params)
+}
+
 // ListAzureContainerRegistries mocks base method.
 func (m *MockAzureClient) ListAzureContainerRegistries(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.ContainerRegistry] {
 	m.ctrl.T.Helper()
@@ -329,6 +706,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureContainerRegistries(ctx, subscri
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureContainerRegistries", reflect.TypeOf((*MockAzureClient)(nil).ListAzureContainerRegistries), ctx, subscriptionId)
 }
 
+// ListAzureCosmosDBAccounts mocks base method.
+func (m *MockAzureClient) ListAzureCosmosDBAccounts(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.CosmosDBAccount] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureCosmosDBAccounts", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.CosmosDBAccount])
+	return ret0
+}
+
+// ListAzureCosmosDBAccounts indicates an expected call of ListAzureCosmosDBAccounts.
+func (mr *MockAzureClientMockRecorder) ListAzureCosmosDBAccounts(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureCosmosDBAccounts", reflect.TypeOf((*MockAzureClient)(nil).ListAzureCosmosDBAccounts), ctx, subscriptionId, params)
+}
+
+// ListAzureDataFactories mocks base method.
+func (m *MockAzureClient) ListAzureDataFactories(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.DataFactory] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureDataFactories", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.DataFactory])
+	return ret0
+}
+
+// ListAzureDataFactories indicates an expected call of ListAzureDataFactories.
+func (mr *MockAzureClientMockRecorder) ListAzureDataFactories(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureDataFactories", reflect.TypeOf((*MockAzureClient)(nil).ListAzureDataFactories), ctx, subscriptionId, params)
+}
+
 // ListAzureDeviceRegisteredOwners mocks base method.
 func (m *MockAzureClient) ListAzureDeviceRegisteredOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan client.AzureResult[json.RawMessage] {
 	m.ctrl.T.Helper()
@@ -371,6 +776,90 @@ func (mr *MockAzureClientMockRecorder) ListAzureFunctionApps(ctx, subscriptionId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureFunctionApps", reflect.TypeOf((*MockAzureClient)(nil).ListAzureFunctionApps), ctx, subscriptionId)
 }
 
+// ListAzureIntuneManagedDevices mocks base method.
+func (m *MockAzureClient) ListAzureIntuneManagedDevices(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.IntuneManagedDevice] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureIntuneManagedDevices", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.IntuneManagedDevice])
+	return ret0
+}
+
+// ListAzureIntuneManagedDevices indicates an expected call of ListAzureIntuneManagedDevices.
+func (mr *MockAzureClientMockRecorder) ListAzureIntuneManagedDevices(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureIntuneManagedDevices", reflect.TypeOf((*MockAzureClient)(nil).ListAzureIntuneManagedDevices), ctx, params)
+}
+
+// ListAzureIntuneRoleAssignments mocks base method.
+func (m *MockAzureClient) ListAzureIntuneRoleAssignments(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.IntuneRoleAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureIntuneRoleAssignments", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.IntuneRoleAssignment])
+	return ret0
+}
+
+// ListAzureIntuneRoleAssignments indicates an expected call of ListAzureIntuneRoleAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzureIntuneRoleAssignments(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureIntuneRoleAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzureIntuneRoleAssignments), ctx, params)
+}
+
+// ListAzureIntuneRoleScopeTags mocks base method.
+func (m *MockAzureClient) ListAzureIntuneRoleScopeTags(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.IntuneRoleScopeTag] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureIntuneRoleScopeTags", ctx, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.IntuneRoleScopeTag])
+	return ret0
+}
+
+// ListAzureIntuneRoleScopeTags indicates an expected call of ListAzureIntuneRoleScopeTags.
+func (mr *MockAzureClientMockRecorder) ListAzureIntuneRoleScopeTags(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureIntuneRoleScopeTags", reflect.TypeOf((*MockAzureClient)(nil).ListAzureIntuneRoleScopeTags), ctx, params)
+}
+
+// ListAzureKeyVaultCertificates mocks base method.
+func (m *MockAzureClient) ListAzureKeyVaultCertificates(ctx context.Context, vaultUri string, params query.RMParams) <-chan client.AzureResult[azure.KeyVaultCertificateItem] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureKeyVaultCertificates", ctx, vaultUri, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KeyVaultCertificateItem])
+	return ret0
+}
+
+// ListAzureKeyVaultCertificates indicates an expected call of ListAzureKeyVaultCertificates.
+func (mr *MockAzureClientMockRecorder) ListAzureKeyVaultCertificates(ctx, vaultUri, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureKeyVaultCertificates", reflect.TypeOf((*MockAzureClient)(nil).ListAzureKeyVaultCertificates), ctx, vaultUri, params)
+}
+
+// ListAzureKeyVaultKeys mocks base method.
+func (m *MockAzureClient) ListAzureKeyVaultKeys(ctx context.Context, vaultUri string, params query.RMParams) <-chan client.AzureResult[azure.KeyVaultKeyItem] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureKeyVaultKeys", ctx, vaultUri, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KeyVaultKeyItem])
+	return ret0
+}
+
+// ListAzureKeyVaultKeys indicates an expected call of ListAzureKeyVaultKeys.
+func (mr *MockAzureClientMockRecorder) ListAzureKeyVaultKeys(ctx, vaultUri, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureKeyVaultKeys", reflect.TypeOf((*MockAzureClient)(nil).ListAzureKeyVaultKeys), ctx, vaultUri, params)
+}
+
+// ListAzureKeyVaultSecrets mocks base method.
+func (m *MockAzureClient) ListAzureKeyVaultSecrets(ctx context.Context, vaultUri string, params query.RMParams) <-chan client.AzureResult[azure.KeyVaultSecretItem] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureKeyVaultSecrets", ctx, vaultUri, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.KeyVaultSecretItem])
+	return ret0
+}
+
+// ListAzureKeyVaultSecrets indicates an expected call of ListAzureKeyVaultSecrets.
+func (mr *MockAzureClientMockRecorder) ListAzureKeyVaultSecrets(ctx, vaultUri, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureKeyVaultSecrets", reflect.TypeOf((*MockAzureClient)(nil).ListAzureKeyVaultSecrets), ctx, vaultUri, params)
+}
+
 // ListAzureKeyVaults mocks base method.
 func (m *MockAzureClient) ListAzureKeyVaults(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.KeyVault] {
 	m.ctrl.T.Helper()
@@ -441,6 +930,76 @@ func (mr *MockAzureClientMockRecorder) ListAzureManagementGroups(ctx, skipToken
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureManagementGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureManagementGroups), ctx, skipToken)
 }
 
+// ListAzureNetworkInterfaces mocks base method.
+func (m *MockAzureClient) ListAzureNetworkInterfaces(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.NetworkInterface] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureNetworkInterfaces", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.NetworkInterface])
+	return ret0
+}
+
+// ListAzureNetworkInterfaces indicates an expected call of ListAzureNetworkInterfaces.
+func (mr *MockAzureClientMockRecorder) ListAzureNetworkInterfaces(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureNetworkInterfaces", reflect.TypeOf((*MockAzureClient)(nil).ListAzureNetworkInterfaces), ctx, subscriptionId, params)
+}
+
+// ListAzureNetworkSecurityGroups mocks base method.
+func (m *MockAzureClient) ListAzureNetworkSecurityGroups(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.NetworkSecurityGroup] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureNetworkSecurityGroups", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.NetworkSecurityGroup])
+	return ret0
+}
+
+// ListAzureNetworkSecurityGroups indicates an expected call of ListAzureNetworkSecurityGroups.
+func (mr *MockAzureClientMockRecorder) ListAzureNetworkSecurityGroups(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureNetworkSecurityGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureNetworkSecurityGroups), ctx, subscriptionId, params)
+}
+
+// ListAzurePolicyAssignments mocks base method.
+func (m *MockAzureClient) ListAzurePolicyAssignments(ctx context.Context, scope string, params query.RMParams) <-chan client.AzureResult[azure.PolicyAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzurePolicyAssignments", ctx, scope, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.PolicyAssignment])
+	return ret0
+}
+
+// ListAzurePolicyAssignments indicates an expected call of ListAzurePolicyAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzurePolicyAssignments(ctx, scope, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzurePolicyAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzurePolicyAssignments), ctx, scope, params)
+}
+
+// ListAzurePublicIPAddresses mocks base method.
+func (m *MockAzureClient) ListAzurePublicIPAddresses(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.PublicIPAddress] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzurePublicIPAddresses", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.PublicIPAddress])
+	return ret0
+}
+
+// ListAzurePublicIPAddresses indicates an expected call of ListAzurePublicIPAddresses.
+func (mr *MockAzureClientMockRecorder) ListAzurePublicIPAddresses(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzurePublicIPAddresses", reflect.TypeOf((*MockAzureClient)(nil).ListAzurePublicIPAddresses), ctx, subscriptionId, params)
+}
+
+// ListAzureRegistrationAssignments mocks base method.
+func (m *MockAzureClient) ListAzureRegistrationAssignments(ctx context.Context, scope string, params query.RMParams) <-chan client.AzureResult[azure.RegistrationAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureRegistrationAssignments", ctx, scope, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.RegistrationAssignment])
+	return ret0
+}
+
+// ListAzureRegistrationAssignments indicates an expected call of ListAzureRegistrationAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzureRegistrationAssignments(ctx, scope, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureRegistrationAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzureRegistrationAssignments), ctx, scope, params)
+}
+
 // ListAzureResourceGroups mocks base method.
 func (m *MockAzureClient) ListAzureResourceGroups(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.ResourceGroup] {
 	m.ctrl.T.Helper()
@@ -455,6 +1014,90 @@ func (mr *MockAzureClientMockRecorder) ListAzureResourceGroups(ctx, subscription
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureResourceGroups", reflect.TypeOf((*MockAzureClient)(nil).ListAzureResourceGroups), ctx, subscriptionId, params)
 }
 
+// ListAzureRoleAssignments mocks base method.
+func (m *MockAzureClient) ListAzureRoleAssignments(ctx context.Context, scope string, params query.RMParams) <-chan client.AzureResult[azure.RoleAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureRoleAssignments", ctx, scope, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.RoleAssignment])
+	return ret0
+}
+
+// ListAzureRoleAssignments indicates an expected call of ListAzureRoleAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzureRoleAssignments(ctx, scope, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureRoleAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzureRoleAssignments), ctx, scope, params)
+}
+
+// ListAzureRoleDefinitions mocks base method.
+func (m *MockAzureClient) ListAzureRoleDefinitions(ctx context.Context, scope string, params query.RMParams) <-chan client.AzureResult[azure.RoleDefinition] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureRoleDefinitions", ctx, scope, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.RoleDefinition])
+	return ret0
+}
+
+// ListAzureRoleDefinitions indicates an expected call of ListAzureRoleDefinitions.
+func (mr *MockAzureClientMockRecorder) ListAzureRoleDefinitions(ctx, scope, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureRoleDefinitions", reflect.TypeOf((*MockAzureClient)(nil).ListAzureRoleDefinitions), ctx, scope, params)
+}
+
+// ListAzureRoleEligibilityScheduleInstances mocks base method.
+func (m *MockAzureClient) ListAzureRoleEligibilityScheduleInstances(ctx context.Context, scope string, params query.RMParams) <-chan client.AzureResult[azure.RoleEligibilityScheduleInstance] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureRoleEligibilityScheduleInstances", ctx, scope, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.RoleEligibilityScheduleInstance])
+	return ret0
+}
+
+// ListAzureRoleEligibilityScheduleInstances indicates an expected call of ListAzureRoleEligibilityScheduleInstances.
+func (mr *MockAzureClientMockRecorder) ListAzureRoleEligibilityScheduleInstances(ctx, scope, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureRoleEligibilityScheduleInstances", reflect.TypeOf((*MockAzureClient)(nil).ListAzureRoleEligibilityScheduleInstances), ctx, scope, params)
+}
+
+// ListAzureRoleManagementPolicyAssignments mocks base method.
+func (m *MockAzureClient) ListAzureRoleManagementPolicyAssignments(ctx context.Context, scope string, params query.RMParams) <-chan client.AzureResult[azure.RoleManagementPolicyAssignment] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureRoleManagementPolicyAssignments", ctx, scope, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.RoleManagementPolicyAssignment])
+	return ret0
+}
+
+// ListAzureRoleManagementPolicyAssignments indicates an expected call of ListAzureRoleManagementPolicyAssignments.
+func (mr *MockAzureClientMockRecorder) ListAzureRoleManagementPolicyAssignments(ctx, scope, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureRoleManagementPolicyAssignments", reflect.TypeOf((*MockAzureClient)(nil).ListAzureRoleManagementPolicyAssignments), ctx, scope, params)
+}
+
+// ListAzureSqlServerFirewallRules mocks base method.
+func (m *MockAzureClient) ListAzureSqlServerFirewallRules(ctx context.Context, serverId string, params query.RMParams) <-chan client.AzureResult[azure.SqlServerFirewallRule] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureSqlServerFirewallRules", ctx, serverId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.SqlServerFirewallRule])
+	return ret0
+}
+
+// ListAzureSqlServerFirewallRules indicates an expected call of ListAzureSqlServerFirewallRules.
+func (mr *MockAzureClientMockRecorder) ListAzureSqlServerFirewallRules(ctx, serverId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureSqlServerFirewallRules", reflect.TypeOf((*MockAzureClient)(nil).ListAzureSqlServerFirewallRules), ctx, serverId, params)
+}
+
+// ListAzureSqlServers mocks base method.
+func (m *MockAzureClient) ListAzureSqlServers(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.SqlServer] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureSqlServers", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.SqlServer])
+	return ret0
+}
+
+// ListAzureSqlServers indicates an expected call of ListAzureSqlServers.
+func (mr *MockAzureClientMockRecorder) ListAzureSqlServers(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureSqlServers", reflect.TypeOf((*MockAzureClient)(nil).ListAzureSqlServers), ctx, subscriptionId, params)
+}
+
 // ListAzureStorageAccounts mocks base method.
 func (m *MockAzureClient) ListAzureStorageAccounts(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.StorageAccount] {
 	m.ctrl.T.Helper()
@@ -497,6 +1140,20 @@ func (mr *MockAzureClientMockRecorder) ListAzureSubscriptions(ctx any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureSubscriptions", reflect.TypeOf((*MockAzureClient)(nil).ListAzureSubscriptions), ctx)
 }
 
+// ListAzureSynapseWorkspaces mocks base method.
+func (m *MockAzureClient) ListAzureSynapseWorkspaces(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.SynapseWorkspace] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureSynapseWorkspaces", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.SynapseWorkspace])
+	return ret0
+}
+
+// ListAzureSynapseWorkspaces indicates an expected call of ListAzureSynapseWorkspaces.
+func (mr *MockAzureClientMockRecorder) ListAzureSynapseWorkspaces(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureSynapseWorkspaces", reflect.TypeOf((*MockAzureClient)(nil).ListAzureSynapseWorkspaces), ctx, subscriptionId, params)
+}
+
 // ListAzureUnifiedRoleEligibilityScheduleInstances mocks base method.
 func (m *MockAzureClient) ListAzureUnifiedRoleEligibilityScheduleInstances(ctx context.Context, params query.GraphParams) <-chan client.AzureResult[azure.UnifiedRoleEligibilityScheduleInstance] {
 	m.ctrl.T.Helper()
@@ -511,6 +1168,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureUnifiedRoleEligibilityScheduleIn
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureUnifiedRoleEligibilityScheduleInstances", reflect.TypeOf((*MockAzureClient)(nil).ListAzureUnifiedRoleEligibilityScheduleInstances), ctx, params)
 }
 
+// ListAzureUserAssignedIdentities mocks base method.
+func (m *MockAzureClient) ListAzureUserAssignedIdentities(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.UserAssignedManagedIdentity] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureUserAssignedIdentities", ctx, subscriptionId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.UserAssignedManagedIdentity])
+	return ret0
+}
+
+// ListAzureUserAssignedIdentities indicates an expected call of ListAzureUserAssignedIdentities.
+func (mr *MockAzureClientMockRecorder) ListAzureUserAssignedIdentities(ctx, subscriptionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureUserAssignedIdentities", reflect.TypeOf((*MockAzureClient)(nil).ListAzureUserAssignedIdentities), ctx, subscriptionId)
+}
+
+// ListAzureUserAssignedIdentityFederatedIdentityCredentials mocks base method.
+func (m *MockAzureClient) ListAzureUserAssignedIdentityFederatedIdentityCredentials(ctx context.Context, identityId string) <-chan client.AzureResult[azure.ManagedIdentityFederatedIdentityCredential] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureUserAssignedIdentityFederatedIdentityCredentials", ctx, identityId)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.ManagedIdentityFederatedIdentityCredential])
+	return ret0
+}
+
+// ListAzureUserAssignedIdentityFederatedIdentityCredentials indicates an expected call of ListAzureUserAssignedIdentityFederatedIdentityCredentials.
+func (mr *MockAzureClientMockRecorder) ListAzureUserAssignedIdentityFederatedIdentityCredentials(ctx, identityId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureUserAssignedIdentityFederatedIdentityCredentials", reflect.TypeOf((*MockAzureClient)(nil).ListAzureUserAssignedIdentityFederatedIdentityCredentials), ctx, identityId)
+}
+
 // ListAzureVMScaleSets mocks base method.
 func (m *MockAzureClient) ListAzureVMScaleSets(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.VMScaleSet] {
 	m.ctrl.T.Helper()
@@ -539,6 +1224,34 @@ func (mr *MockAzureClientMockRecorder) ListAzureVirtualMachines(ctx, subscriptio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureVirtualMachines", reflect.TypeOf((*MockAzureClient)(nil).ListAzureVirtualMachines), ctx, subscriptionId, params)
 }
 
+// ListAzureVirtualNetworks mocks base method.
+func (m *MockAzureClient) ListAzureVirtualNetworks(ctx context.Context, subscriptionId string, params query.RMParams) <-chan client.AzureResult[azure.VirtualNetwork] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureVirtualNetworks", ctx, subscriptionId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.VirtualNetwork])
+	return ret0
+}
+
+// ListAzureVirtualNetworks indicates an expected call of ListAzureVirtualNetworks.
+func (mr *MockAzureClientMockRecorder) ListAzureVirtualNetworks(ctx, subscriptionId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureVirtualNetworks", reflect.TypeOf((*MockAzureClient)(nil).ListAzureVirtualNetworks), ctx, subscriptionId, params)
+}
+
+// ListAzureWebAppSlots mocks base method.
+func (m *MockAzureClient) ListAzureWebAppSlots(ctx context.Context, siteId string, params query.RMParams) <-chan client.AzureResult[azure.WebApp] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAzureWebAppSlots", ctx, siteId, params)
+	ret0, _ := ret[0].(<-chan client.AzureResult[azure.WebApp])
+	return ret0
+}
+
+// ListAzureWebAppSlots indicates an expected call of ListAzureWebAppSlots.
+func (mr *MockAzureClientMockRecorder) ListAzureWebAppSlots(ctx, siteId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAzureWebAppSlots", reflect.TypeOf((*MockAzureClient)(nil).ListAzureWebAppSlots), ctx, siteId, params)
+}
+
 // ListAzureWebApps mocks base method.
 func (m *MockAzureClient) ListAzureWebApps(ctx context.Context, subscriptionId string) <-chan client.AzureResult[azure.WebApp] {
 	m.ctrl.T.Helper()
@@ -593,4 +1306,4 @@ func (m *MockAzureClient) TenantInfo() azure.Tenant {
 func (mr *MockAzureClientMockRecorder) TenantInfo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TenantInfo", reflect.TypeOf((*MockAzureClient)(nil).TenantInfo))
-}
\ No newline at end of file
+}
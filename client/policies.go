@@ -0,0 +1,61 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/client/rest"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzurePolicyAssignments https://learn.microsoft.com/en-us/rest/api/policy/policy-assignments/list-for-management-group?view=rest-policy-2023-04-01
+func (s *azureClient) ListAzurePolicyAssignments(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.PolicyAssignment] {
+	var (
+		out  = make(chan AzureResult[azure.PolicyAssignment])
+		path = fmt.Sprintf("%s/providers/Microsoft.Authorization/policyAssignments", scope)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2023-04-01"
+	}
+
+	go getAzureObjectList[azure.PolicyAssignment](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// GetAzurePolicyDefinition https://learn.microsoft.com/en-us/rest/api/policy/policy-definitions/get?view=rest-policy-2023-04-01
+//
+// The definitionId may refer to either a policy definition or a policy set definition.
+func (s *azureClient) GetAzurePolicyDefinition(ctx context.Context, definitionId string) (azure.PolicyDefinition, error) {
+	var (
+		params   = query.RMParams{ApiVersion: "2023-04-01"}
+		response azure.PolicyDefinition
+	)
+
+	if res, err := s.resourceManager.Get(ctx, definitionId, params, nil); err != nil {
+		return response, err
+	} else if err := rest.Decode(res.Body, &response); err != nil {
+		return response, err
+	} else {
+		return response, nil
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzurePublicIPAddresses https://learn.microsoft.com/en-us/rest/api/virtualnetwork/public-ip-addresses/list-all?view=rest-virtualnetwork-2023-09-01
+func (s *azureClient) ListAzurePublicIPAddresses(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.PublicIPAddress] {
+	var (
+		out  = make(chan AzureResult[azure.PublicIPAddress])
+		path = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Network/publicIPAddresses", subscriptionId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2023-09-01"
+	}
+
+	go getAzureObjectList[azure.PublicIPAddress](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -26,6 +26,7 @@ const (
 	ApiVersion                 string = "api-version"
 	Count                      string = "$count"
 	Expand                     string = "$expand"
+	ExpandRegistrationDef      string = "$expandRegistrationDefinition"
 	Filter                     string = "$filter"
 	Format                     string = "$format"
 	IncludeDeleted             string = "$include"
@@ -50,6 +51,7 @@ type Params interface {
 type RMParams struct {
 	ApiVersion                 string
 	Expand                     string
+	ExpandRegistrationDef      bool // For Azure Lighthouse registration assignments
 	Filter                     string
 	IncludeDeleted             string
 	IncludeAllTenantCategories bool
@@ -76,6 +78,10 @@ func (s RMParams) AsMap() map[string]string {
 		params[Expand] = s.Expand
 	}
 
+	if s.ExpandRegistrationDef {
+		params[ExpandRegistrationDef] = "true"
+	}
+
 	if s.Filter != "" {
 		params[Filter] = s.Filter
 	}
@@ -76,7 +76,7 @@ func NewManagedIdentityAuthenticator(config config.Config, auth *url.URL, api *u
 		auth: &ManagedIdentityAuthStrategy{
 			config:  config,
 			authUrl: *auth,
-			api:     *api,
+			api:     TokenAudience(*api),
 			tenant:  config.Tenant,
 		},
 		mutex: sync.RWMutex{},
@@ -88,7 +88,7 @@ func NewGenericAuthenticator(config config.Config, auth *url.URL, api *url.URL)
 	return &Authenticator{
 		auth: &GenericAuthStrategy{config: config,
 			authUrl:       *auth,
-			api:           *api,
+			api:           TokenAudience(*api),
 			jwt:           config.JWT,
 			clientId:      config.ApplicationId,
 			clientSecret:  config.ClientSecret,
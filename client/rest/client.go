@@ -72,6 +72,20 @@ func NewRestClient(apiUrl string, config config.Config) (RestClient, error) {
 	}
 }
 
+// NewSharedRestClient returns a client for apiUrl that shares the connections and authenticator of parent. It is meant
+// for apis with the same token audience, such as the data planes of different key vaults, so that they share a token.
+func NewSharedRestClient(apiUrl string, parent RestClient) (RestClient, error) {
+	if api, err := url.Parse(apiUrl); err != nil {
+		return nil, err
+	} else if parent, ok := parent.(*restClient); !ok {
+		return nil, fmt.Errorf("unable to share a rest client of type %T", parent)
+	} else {
+		client := *parent
+		client.api = *api
+		return &client, nil
+	}
+}
+
 type restClient struct {
 	api            url.URL
 	http           *http.Client
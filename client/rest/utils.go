@@ -29,6 +29,7 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -106,6 +107,29 @@ func ParseAud(accessToken string) (string, error) {
 	}
 }
 
+// Key Vault and Managed HSM data planes are served from a host per vault but issue tokens for a single audience
+// per cloud, e.g. https://myvault.vault.azure.net is accessed with a token for https://vault.azure.net
+var dataPlaneAudienceSuffixes = []string{
+	"vault.azure.net",
+	"vault.azure.cn",
+	"vault.usgovcloudapi.net",
+	"vault.microsoftazure.de",
+	"managedhsm.azure.net",
+	"managedhsm.azure.cn",
+	"managedhsm.usgovcloudapi.net",
+}
+
+// TokenAudience returns the audience a token must be issued for to call the given api
+func TokenAudience(api url.URL) url.URL {
+	host := strings.ToLower(api.Hostname())
+	for _, suffix := range dataPlaneAudienceSuffixes {
+		if strings.HasSuffix(host, "."+suffix) {
+			return url.URL{Scheme: "https", Host: suffix}
+		}
+	}
+	return api
+}
+
 func parseRSAPrivateKey(signingKey string, password string) (interface{}, error) {
 	if decodedBlock, _ := pem.Decode([]byte(signingKey)); decodedBlock == nil {
 		return nil, fmt.Errorf("Unable to decode private key")
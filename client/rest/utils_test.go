@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package rest
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestTokenAudience(t *testing.T) {
+	tests := map[string]string{
+		"https://management.azure.com":                "https://management.azure.com",
+		"https://graph.microsoft.com":                 "https://graph.microsoft.com",
+		"https://myvault.vault.azure.net/":            "https://vault.azure.net",
+		"https://MyVault.Vault.Azure.Net":             "https://vault.azure.net",
+		"https://myvault.vault.usgovcloudapi.net/":    "https://vault.usgovcloudapi.net",
+		"https://myhsm.managedhsm.azure.net/":         "https://managedhsm.azure.net",
+		"https://vault.azure.net.example.com/secrets": "https://vault.azure.net.example.com/secrets",
+	}
+
+	for input, expected := range tests {
+		if api, err := url.Parse(input); err != nil {
+			t.Fatalf("unable to parse %s: %v", input, err)
+		} else if audience := TokenAudience(*api); audience.String() != expected {
+			t.Errorf("got %s for %s, want %s", audience.String(), input, expected)
+		}
+	}
+}
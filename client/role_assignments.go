@@ -53,3 +53,19 @@ func (s *azureClient) ListRoleAssignmentsForResource(ctx context.Context, resour
 
 	return out
 }
+
+// ListAzureRoleAssignments https://learn.microsoft.com/en-us/rest/api/authorization/role-assignments/list-for-scope?view=rest-authorization-2022-04-01
+func (s *azureClient) ListAzureRoleAssignments(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RoleAssignment] {
+	var (
+		out  = make(chan AzureResult[azure.RoleAssignment])
+		path = fmt.Sprintf("%s/providers/Microsoft.Authorization/roleAssignments", scope)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2022-04-01"
+	}
+
+	go getAzureObjectList[azure.RoleAssignment](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -20,22 +20,39 @@ package client
 import (
 	"context"
 	"fmt"
+
 	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 )
 
-// ListAzureRoleEligibilityScheduleInstances https://learn.microsoft.com/en-us/graph/api/resources/unifiedroleeligibilityscheduleinstance?view=graph-rest-1.0
-func (s *azureClient) ListAzureRoleEligibilityScheduleInstances(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.UnifiedRoleEligibilityScheduleInstance] {
+// ListAzureRoleEligibilityScheduleInstances https://learn.microsoft.com/en-us/rest/api/authorization/role-eligibility-schedule-instances/list-for-scope?view=rest-authorization-2020-10-01
+func (s *azureClient) ListAzureRoleEligibilityScheduleInstances(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RoleEligibilityScheduleInstance] {
+	var (
+		out  = make(chan AzureResult[azure.RoleEligibilityScheduleInstance])
+		path = fmt.Sprintf("%s/providers/Microsoft.Authorization/roleEligibilityScheduleInstances", scope)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2020-10-01"
+	}
+
+	go getAzureObjectList[azure.RoleEligibilityScheduleInstance](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureRoleManagementPolicyAssignments https://learn.microsoft.com/en-us/rest/api/authorization/role-management-policy-assignments/list-for-scope?view=rest-authorization-2020-10-01
+func (s *azureClient) ListAzureRoleManagementPolicyAssignments(ctx context.Context, scope string, params query.RMParams) <-chan AzureResult[azure.RoleManagementPolicyAssignment] {
 	var (
-		out  = make(chan AzureResult[azure.UnifiedRoleEligibilityScheduleInstance])
-		path = fmt.Sprintf("/subscriptions/%s/resourcegroups", subscriptionId)
+		out  = make(chan AzureResult[azure.RoleManagementPolicyAssignment])
+		path = fmt.Sprintf("%s/providers/Microsoft.Authorization/roleManagementPolicyAssignments", scope)
 	)
 
 	if params.ApiVersion == "" {
-		params.ApiVersion = "2021-04-01"
+		params.ApiVersion = "2020-10-01"
 	}
 
-	go getAzureObjectList[azure.UnifiedRoleEligibilityScheduleInstance](s.resourceManager, ctx, path, params, out)
+	go getAzureObjectList[azure.RoleManagementPolicyAssignment](s.resourceManager, ctx, path, params, out)
 
 	return out
 }
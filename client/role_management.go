@@ -30,6 +30,8 @@ import (
 type AzureRoleManagementClient interface {
 	ListAzureUnifiedRoleEligibilityScheduleInstances(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.UnifiedRoleEligibilityScheduleInstance]
 	ListRoleAssignmentPolicies(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.UnifiedRoleManagementPolicyAssignment]
+	ListAzureADGroupEligibilityScheduleInstances(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance]
+	ListAzureADGroupAssignmentScheduleInstances(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.PrivilegedAccessGroupAssignmentScheduleInstance]
 }
 
 // ListAzureUnifiedRoleEligibilityScheduleInstances https://learn.microsoft.com/en-us/graph/api/resources/unifiedroleeligibilityscheduleinstance?view=graph-rest-1.0
@@ -58,3 +60,31 @@ func (s *azureClient) ListRoleAssignmentPolicies(ctx context.Context, params que
 
 	return out
 }
+
+// ListAzureADGroupEligibilityScheduleInstances makes a GET request to https://graph.microsoft.com/v1.0/identityGovernance/privilegedAccess/group/eligibilityScheduleInstances
+// This endpoint requires the PrivilegedEligibilitySchedule.Read.AzureADGroup permission and a $filter on groupId or principalId
+// Endpoint documentation: https://learn.microsoft.com/en-us/graph/api/privilegedaccessgroup-list-eligibilityscheduleinstances?view=graph-rest-1.0
+func (s *azureClient) ListAzureADGroupEligibilityScheduleInstances(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance] {
+	var (
+		out  = make(chan AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance])
+		path = fmt.Sprintf("/%s/identityGovernance/privilegedAccess/group/eligibilityScheduleInstances", constants.GraphApiVersion)
+	)
+
+	go getAzureObjectList[azure.PrivilegedAccessGroupEligibilityScheduleInstance](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureADGroupAssignmentScheduleInstances makes a GET request to https://graph.microsoft.com/v1.0/identityGovernance/privilegedAccess/group/assignmentScheduleInstances
+// This endpoint requires the PrivilegedAssignmentSchedule.Read.AzureADGroup permission and a $filter on groupId or principalId
+// Endpoint documentation: https://learn.microsoft.com/en-us/graph/api/privilegedaccessgroup-list-assignmentscheduleinstances?view=graph-rest-1.0
+func (s *azureClient) ListAzureADGroupAssignmentScheduleInstances(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.PrivilegedAccessGroupAssignmentScheduleInstance] {
+	var (
+		out  = make(chan AzureResult[azure.PrivilegedAccessGroupAssignmentScheduleInstance])
+		path = fmt.Sprintf("/%s/identityGovernance/privilegedAccess/group/assignmentScheduleInstances", constants.GraphApiVersion)
+	)
+
+	go getAzureObjectList[azure.PrivilegedAccessGroupAssignmentScheduleInstance](s.msgraph, ctx, path, params, out)
+
+	return out
+}
@@ -43,6 +43,19 @@ func (s *azureClient) ListAzureADServicePrincipals(ctx context.Context, params q
 	return out
 }
 
+// ListAzureADServicePrincipalSignInActivities https://learn.microsoft.com/en-us/graph/api/reportroot-list-serviceprincipalsigninactivities?view=graph-rest-beta
+// This endpoint requires the AuditLog.Read.All permission and a Microsoft Entra ID P1 or P2 license
+func (s *azureClient) ListAzureADServicePrincipalSignInActivities(ctx context.Context, params query.GraphParams) <-chan AzureResult[azure.ServicePrincipalSignInActivity] {
+	var (
+		out  = make(chan AzureResult[azure.ServicePrincipalSignInActivity])
+		path = fmt.Sprintf("/%s/reports/servicePrincipalSignInActivities", constants.GraphApiBetaVersion)
+	)
+
+	go getAzureObjectList[azure.ServicePrincipalSignInActivity](s.msgraph, ctx, path, params, out)
+
+	return out
+}
+
 // ListAzureADServicePrincipalOwners https://learn.microsoft.com/en-us/graph/api/serviceprincipal-list-owners?view=graph-rest-beta
 func (s *azureClient) ListAzureADServicePrincipalOwners(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
 	var (
@@ -0,0 +1,58 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureSqlServers https://learn.microsoft.com/en-us/rest/api/sql/servers/list?view=rest-sql-2021-11-01
+func (s *azureClient) ListAzureSqlServers(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.SqlServer] {
+	var (
+		out  = make(chan AzureResult[azure.SqlServer])
+		path = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Sql/servers", subscriptionId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2021-11-01"
+	}
+
+	go getAzureObjectList[azure.SqlServer](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureSqlServerFirewallRules https://learn.microsoft.com/en-us/rest/api/sql/firewall-rules/list-by-server?view=rest-sql-2021-11-01
+func (s *azureClient) ListAzureSqlServerFirewallRules(ctx context.Context, serverId string, params query.RMParams) <-chan AzureResult[azure.SqlServerFirewallRule] {
+	var (
+		out  = make(chan AzureResult[azure.SqlServerFirewallRule])
+		path = fmt.Sprintf("%s/firewallRules", serverId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2021-11-01"
+	}
+
+	go getAzureObjectList[azure.SqlServerFirewallRule](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -0,0 +1,42 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// ListAzureSynapseWorkspaces https://learn.microsoft.com/en-us/rest/api/synapse/workspaces/list?view=rest-synapse-2021-06-01
+func (s *azureClient) ListAzureSynapseWorkspaces(ctx context.Context, subscriptionId string, params query.RMParams) <-chan AzureResult[azure.SynapseWorkspace] {
+	var (
+		out  = make(chan AzureResult[azure.SynapseWorkspace])
+		path = fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Synapse/workspaces", subscriptionId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2021-06-01"
+	}
+
+	go getAzureObjectList[azure.SynapseWorkspace](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -53,3 +53,15 @@ func (s *azureClient) ListAzureADUsersInteractions(ctx context.Context, id strin
 
 	return out
 }
+
+// ListAzureADUserTransitiveMemberOf https://learn.microsoft.com/en-us/graph/api/user-list-transitivememberof?view=graph-rest-1.0
+func (s *azureClient) ListAzureADUserTransitiveMemberOf(ctx context.Context, objectId string, params query.GraphParams) <-chan AzureResult[json.RawMessage] {
+	var (
+		out  = make(chan AzureResult[json.RawMessage])
+		path = fmt.Sprintf("/%s/users/%s/transitiveMemberOf", constants.GraphApiVersion, objectId)
+	)
+
+	go getAzureObjectList[json.RawMessage](s.msgraph, ctx, path, params, out)
+
+	return out
+}
@@ -37,3 +37,37 @@ func (s *azureClient) ListAzureWebApps(ctx context.Context, subscriptionId strin
 
 	return out
 }
+
+// ListAzureWebAppSlots https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/list-slots?view=rest-appservice-2022-03-01
+func (s *azureClient) ListAzureWebAppSlots(ctx context.Context, siteId string, params query.RMParams) <-chan AzureResult[azure.WebApp] {
+	var (
+		out  = make(chan AzureResult[azure.WebApp])
+		path = fmt.Sprintf("%s/slots", siteId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2022-03-01"
+	}
+
+	go getAzureObjectList[azure.WebApp](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
+
+// ListAzureBasicPublishingCredentialsPolicies https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/list-basic-publishing-credentials-policies?view=rest-appservice-2022-03-01
+//
+// The site id may be that of a web app, a function app or one of their deployment slots.
+func (s *azureClient) ListAzureBasicPublishingCredentialsPolicies(ctx context.Context, siteId string, params query.RMParams) <-chan AzureResult[azure.PublishingCredentialsPolicy] {
+	var (
+		out  = make(chan AzureResult[azure.PublishingCredentialsPolicy])
+		path = fmt.Sprintf("%s/basicPublishingCredentialsPolicies", siteId)
+	)
+
+	if params.ApiVersion == "" {
+		params.ApiVersion = "2022-03-01"
+	}
+
+	go getAzureObjectList[azure.PublishingCredentialsPolicy](s.resourceManager, ctx, path, params, out)
+
+	return out
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listAdministrativeUnitMembersCmd)
+}
+
+var listAdministrativeUnitMembersCmd = &cobra.Command{
+	Use:          "administrative-unit-members",
+	Long:         "Lists Azure AD Administrative Unit Members",
+	Run:          listAdministrativeUnitMembersCmdImpl,
+	SilenceUsage: true,
+}
+
+func listAdministrativeUnitMembersCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad administrative unit members...")
+	start := time.Now()
+	stream := listAdministrativeUnitMembers(ctx, azClient, listAdministrativeUnits(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listAdministrativeUnitMembers(ctx context.Context, client client.AzureClient, administrativeUnits <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		units   = make(chan models.AdministrativeUnit)
+		streams = pipeline.Demux(ctx.Done(), units, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		params  = query.GraphParams{
+			Select: []string{"id", "displayName"},
+		}
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(units)
+
+		for result := range pipeline.OrDone(ctx.Done(), administrativeUnits) {
+			if administrativeUnit, ok := result.(AzureWrapper).Data.(models.AdministrativeUnit); !ok {
+				log.Error(fmt.Errorf("failed administrative unit type assertion"), "unable to continue enumerating administrative unit members", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), units, administrativeUnit); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for administrativeUnit := range stream {
+				chunks := newRecordChunks(func(items []models.AdministrativeUnitMember) bool {
+					return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZAdministrativeUnitMember,
+						Data: models.AdministrativeUnitMembers{
+							AdministrativeUnitId:         administrativeUnit.Id,
+							IsMemberManagementRestricted: administrativeUnit.IsMemberManagementRestricted,
+							Members:                      items,
+						},
+					})
+				})
+				for item := range client.ListAzureADAdministrativeUnitMembers(ctx, administrativeUnit.Id, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing members for this administrative unit", "administrativeUnitId", administrativeUnit.Id)
+					} else {
+						member := models.AdministrativeUnitMember{
+							Member:               item.Ok,
+							AdministrativeUnitId: administrativeUnit.Id,
+						}
+						log.V(2).Info("found administrative unit member", "administrativeUnitMember", member)
+						if ok := chunks.add(member); !ok {
+							return
+						}
+					}
+				}
+				if ok := chunks.flush(); !ok {
+					return
+				}
+				log.V(1).Info("finished listing administrative unit members", "administrativeUnitId", administrativeUnit.Id, "count", chunks.count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing members for all administrative units")
+	}()
+
+	return out
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listAdministrativeUnitScopedRolesCmd)
+}
+
+var listAdministrativeUnitScopedRolesCmd = &cobra.Command{
+	Use:          "administrative-unit-scoped-roles",
+	Long:         "Lists Azure AD Directory Role Members Scoped to Administrative Units",
+	Run:          listAdministrativeUnitScopedRolesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listAdministrativeUnitScopedRolesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad administrative unit scoped role members...")
+	start := time.Now()
+	stream := listAdministrativeUnitScopedRoles(ctx, azClient, listAdministrativeUnits(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listAdministrativeUnitScopedRoles(ctx context.Context, client client.AzureClient, administrativeUnits <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		units   = make(chan models.AdministrativeUnit)
+		streams = pipeline.Demux(ctx.Done(), units, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+
+		// scopedRoleMembers reference activated directory roles, which are mapped back to their role template id so
+		// that they line up with the unified role definitions collected by listRoles
+		roleTemplateIds = make(map[string]string)
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(units)
+
+		for item := range client.ListAzureADDirectoryRoles(ctx, query.GraphParams{}) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to list directory roles; scoped role members will not be resolved to role definitions")
+				break
+			} else {
+				roleTemplateIds[item.Ok.Id] = item.Ok.RoleTemplateId
+			}
+		}
+
+		for result := range pipeline.OrDone(ctx.Done(), administrativeUnits) {
+			if administrativeUnit, ok := result.(AzureWrapper).Data.(models.AdministrativeUnit); !ok {
+				log.Error(fmt.Errorf("failed administrative unit type assertion"), "unable to continue enumerating administrative unit scoped roles", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), units, administrativeUnit); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for administrativeUnit := range stream {
+				var (
+					data = models.AdministrativeUnitScopedRoles{
+						AdministrativeUnitId:         administrativeUnit.Id,
+						IsMemberManagementRestricted: administrativeUnit.IsMemberManagementRestricted,
+						TenantId:                     client.TenantInfo().TenantId,
+					}
+					count = 0
+				)
+				for item := range client.ListAzureADAdministrativeUnitScopedRoleMembers(ctx, administrativeUnit.Id, query.GraphParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing scoped role members for this administrative unit", "administrativeUnitId", administrativeUnit.Id)
+					} else {
+						scopedRole := models.AdministrativeUnitScopedRole{
+							ScopedRoleMembership: item.Ok,
+							RoleDefinitionId:     roleTemplateIds[item.Ok.RoleId],
+						}
+						log.V(2).Info("found administrative unit scoped role member", "administrativeUnitScopedRole", scopedRole)
+						count++
+						data.ScopedRoles = append(data.ScopedRoles, scopedRole)
+					}
+				}
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZAdministrativeUnitScopedRole,
+					Data: data,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing administrative unit scoped role members", "administrativeUnitId", administrativeUnit.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing scoped role members for all administrative units")
+	}()
+
+	return out
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListAdministrativeUnitScopedRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockUnitsChannel := make(chan interface{})
+	mockDirectoryRolesChannel := make(chan client.AzureResult[azure.DirectoryRole])
+	mockScopedRolesChannel := make(chan client.AzureResult[azure.ScopedRoleMembership])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureADDirectoryRoles(gomock.Any(), gomock.Any()).Return(mockDirectoryRolesChannel).Times(1)
+	mockClient.EXPECT().ListAzureADAdministrativeUnitScopedRoleMembers(gomock.Any(), "au", gomock.Any()).Return(mockScopedRolesChannel).Times(1)
+	channel := listAdministrativeUnitScopedRoles(ctx, mockClient, mockUnitsChannel)
+
+	go func() {
+		defer close(mockDirectoryRolesChannel)
+		role := azure.DirectoryRole{RoleTemplateId: "userAdministratorTemplate"}
+		role.Id = "directoryRole"
+		mockDirectoryRolesChannel <- client.AzureResult[azure.DirectoryRole]{
+			Ok: role,
+		}
+	}()
+	go func() {
+		defer close(mockUnitsChannel)
+		unit := models.AdministrativeUnit{}
+		unit.Id = "au"
+		unit.IsMemberManagementRestricted = true
+		mockUnitsChannel <- AzureWrapper{
+			Data: unit,
+		}
+	}()
+	go func() {
+		defer close(mockScopedRolesChannel)
+		mockScopedRolesChannel <- client.AzureResult[azure.ScopedRoleMembership]{
+			Ok: azure.ScopedRoleMembership{AdministrativeUnitId: "au", RoleId: "directoryRole"},
+		}
+		mockScopedRolesChannel <- client.AzureResult[azure.ScopedRoleMembership]{
+			Error: mockError,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.AdministrativeUnitScopedRoles); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.AdministrativeUnitScopedRoles{})
+	} else if !data.IsMemberManagementRestricted {
+		t.Errorf("expected administrative unit to be marked as restricted management")
+	} else if len(data.ScopedRoles) != 1 {
+		t.Errorf("got %v, want %v", len(data.ScopedRoles), 1)
+	} else if data.ScopedRoles[0].RoleDefinitionId != "userAdministratorTemplate" {
+		t.Errorf("got %v, want %v", data.ScopedRoles[0].RoleDefinitionId, "userAdministratorTemplate")
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
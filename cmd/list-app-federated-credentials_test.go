@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListAppFederatedCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockAppsChannel := make(chan azureWrapper[models.App])
+	mockCredentialChannel := make(chan client.AzureResult[azure.FederatedIdentityCredential])
+	mockCredentialChannel2 := make(chan client.AzureResult[azure.FederatedIdentityCredential])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureADAppFederatedIdentityCredentials(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockCredentialChannel).Times(1)
+	mockClient.EXPECT().ListAzureADAppFederatedIdentityCredentials(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockCredentialChannel2).Times(1)
+	channel := listAppFederatedCredentials(ctx, mockClient, mockAppsChannel)
+
+	go func() {
+		defer close(mockAppsChannel)
+		mockAppsChannel <- NewAzureWrapper(enums.KindAZApp, models.App{})
+		mockAppsChannel <- NewAzureWrapper(enums.KindAZApp, models.App{})
+	}()
+	go func() {
+		defer close(mockCredentialChannel)
+		mockCredentialChannel <- client.AzureResult[azure.FederatedIdentityCredential]{
+			Ok: azure.FederatedIdentityCredential{
+				Issuer:  "https://token.actions.githubusercontent.com",
+				Subject: "repo:org/repo:ref:refs/heads/main",
+			},
+		}
+		mockCredentialChannel <- client.AzureResult[azure.FederatedIdentityCredential]{
+			Ok: azure.FederatedIdentityCredential{},
+		}
+	}()
+	go func() {
+		defer close(mockCredentialChannel2)
+		mockCredentialChannel2 <- client.AzureResult[azure.FederatedIdentityCredential]{
+			Ok: azure.FederatedIdentityCredential{},
+		}
+		mockCredentialChannel2 <- client.AzureResult[azure.FederatedIdentityCredential]{
+			Error: mockError,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if len(result.Data.FederatedCredentials) != 2 {
+		t.Errorf("got %v, want %v", len(result.Data.FederatedCredentials), 2)
+	}
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if len(result.Data.FederatedCredentials) != 1 {
+		t.Errorf("got %v, want %v", len(result.Data.FederatedCredentials), 1)
+	}
+}
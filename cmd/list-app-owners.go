@@ -75,12 +75,15 @@ func listAppOwners(ctx context.Context, client client.AzureClient, apps <-chan a
 			defer panicrecovery.PanicRecovery()
 			defer wg.Done()
 			for app := range stream {
-				var (
-					data = models.AppOwners{
-						AppId: app.Data.AppId,
-					}
-					count = 0
-				)
+				chunks := newRecordChunks(func(items []models.AppOwner) bool {
+					return pipeline.Send(ctx.Done(), out, NewAzureWrapper(
+						enums.KindAZAppOwner,
+						models.AppOwners{
+							AppId:  app.Data.AppId,
+							Owners: items,
+						},
+					))
+				})
 				for item := range client.ListAzureADAppOwners(ctx, app.Data.Id, params) {
 					if item.Error != nil {
 						log.Error(item.Error, "unable to continue processing owners for this app", "appId", app.Data.AppId)
@@ -90,18 +93,15 @@ func listAppOwners(ctx context.Context, client client.AzureClient, apps <-chan a
 							AppId: app.Data.Id,
 						}
 						log.V(2).Info("found app owner", "appOwner", appOwner)
-						count++
-						data.Owners = append(data.Owners, appOwner)
+						if ok := chunks.add(appOwner); !ok {
+							return
+						}
 					}
 				}
-
-				if ok := pipeline.Send(ctx.Done(), out, NewAzureWrapper(
-					enums.KindAZAppOwner,
-					data,
-				)); !ok {
+				if ok := chunks.flush(); !ok {
 					return
 				}
-				log.V(1).Info("finished listing app owners", "appId", app.Data.AppId, "count", count)
+				log.V(1).Info("finished listing app owners", "appId", app.Data.AppId, "count", chunks.count)
 			}
 		}()
 	}
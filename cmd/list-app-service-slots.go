@@ -0,0 +1,163 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listAppServiceSlotsCmd)
+}
+
+var listAppServiceSlotsCmd = &cobra.Command{
+	Use:          "app-service-slots",
+	Long:         "Lists Azure Web App and Function App Deployment Slots",
+	Run:          listAppServiceSlotsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listAppServiceSlotsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure app service deployment slots...")
+	start := time.Now()
+
+	var (
+		subscriptions  = make(chan interface{})
+		subscriptions2 = make(chan interface{})
+	)
+	pipeline.Tee(ctx.Done(), listSubscriptions(ctx, azClient), subscriptions, subscriptions2)
+
+	stream := listAppServiceSlots(ctx, azClient, listWebApps(ctx, azClient, subscriptions), listFunctionApps(ctx, azClient, subscriptions2))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+type appServiceSite struct {
+	id   string
+	kind enums.Kind
+}
+
+// listAppServiceSlots lists the deployment slots of the web apps and function apps in sites
+func listAppServiceSlots(ctx context.Context, client client.AzureClient, sites ...<-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		siteIds = make(chan appServiceSite)
+		streams = pipeline.Demux(ctx.Done(), siteIds, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		siteWg  sync.WaitGroup
+	)
+
+	siteWg.Add(len(sites))
+	for i := range sites {
+		stream := sites[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer siteWg.Done()
+
+			for result := range pipeline.OrDone(ctx.Done(), stream) {
+				var site appServiceSite
+				if wrapper, ok := result.(AzureWrapper); !ok {
+					log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating app service slots", "result", result)
+					return
+				} else if webApp, ok := wrapper.Data.(models.WebApp); ok {
+					site = appServiceSite{id: webApp.Id, kind: wrapper.Kind}
+				} else if functionApp, ok := wrapper.Data.(models.FunctionApp); ok {
+					site = appServiceSite{id: functionApp.Id, kind: wrapper.Kind}
+				} else {
+					log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating app service slots", "result", result)
+					return
+				}
+
+				if ok := pipeline.Send(ctx.Done(), siteIds, site); !ok {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		siteWg.Wait()
+		close(siteIds)
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for site := range stream {
+				count := 0
+				for item := range client.ListAzureWebAppSlots(ctx, site.id, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing deployment slots for this site", "siteId", site.id)
+					} else {
+						slot := models.AppServiceSlot{
+							WebApp:            item.Ok,
+							SiteId:            site.id,
+							SiteKind:          site.kind,
+							SubscriptionId:    path.Dir(path.Dir(item.Ok.ResourceGroupId())),
+							ResourceGroupId:   item.Ok.ResourceGroupId(),
+							ResourceGroupName: item.Ok.ResourceGroupName(),
+							TenantId:          client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found app service slot", "slot", slot)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZAppServiceSlot,
+							Data: slot,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing app service slots", "siteId", site.id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all app service slots")
+	}()
+
+	return withBasicPublishingCredentialsPolicies(ctx, client, out)
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListAppServiceSlots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		webAppId      = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Web/sites/web"
+		functionAppId = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Web/sites/func"
+		slotId        = webAppId + "/slots/staging"
+
+		mockWebAppsChannel       = make(chan interface{})
+		mockFunctionAppsChannel  = make(chan interface{})
+		mockWebAppSlotsChannel   = make(chan client.AzureResult[azure.WebApp])
+		mockFunctionSlotsChannel = make(chan client.AzureResult[azure.WebApp])
+		mockPoliciesChannel      = make(chan client.AzureResult[azure.PublishingCredentialsPolicy])
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureWebAppSlots(gomock.Any(), webAppId, query.RMParams{}).Return(mockWebAppSlotsChannel).Times(1)
+	mockClient.EXPECT().ListAzureWebAppSlots(gomock.Any(), functionAppId, query.RMParams{}).Return(mockFunctionSlotsChannel).Times(1)
+	mockClient.EXPECT().ListAzureBasicPublishingCredentialsPolicies(gomock.Any(), slotId, query.RMParams{}).Return(mockPoliciesChannel).Times(1)
+	channel := listAppServiceSlots(ctx, mockClient, mockWebAppsChannel, mockFunctionAppsChannel)
+
+	go func() {
+		defer close(mockWebAppsChannel)
+		mockWebAppsChannel <- AzureWrapper{
+			Kind: enums.KindAZWebApp,
+			Data: models.WebApp{WebApp: azure.WebApp{Entity: azure.Entity{Id: webAppId}}},
+		}
+	}()
+	go func() {
+		defer close(mockFunctionAppsChannel)
+		mockFunctionAppsChannel <- AzureWrapper{
+			Kind: enums.KindAZFunctionApp,
+			Data: models.FunctionApp{FunctionApp: azure.FunctionApp{Entity: azure.Entity{Id: functionAppId}}},
+		}
+	}()
+	go func() {
+		defer close(mockWebAppSlotsChannel)
+		mockWebAppSlotsChannel <- client.AzureResult[azure.WebApp]{
+			Ok: azure.WebApp{
+				Entity:   azure.Entity{Id: slotId},
+				Identity: azure.ManagedIdentity{PrincipalId: "principal"},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockFunctionSlotsChannel)
+	}()
+	go func() {
+		defer close(mockPoliciesChannel)
+		mockPoliciesChannel <- client.AzureResult[azure.PublishingCredentialsPolicy]{
+			Ok: azure.PublishingCredentialsPolicy{Entity: azure.Entity{Id: slotId + "/basicPublishingCredentialsPolicies/scm"}},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.AppServiceSlot); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.AppServiceSlot{})
+	} else {
+		if data.SiteId != webAppId || data.SiteKind != enums.KindAZWebApp || data.SubscriptionId != "/subscriptions/foo" {
+			t.Errorf("got slot of %s (%s) in %s, want a slot of %s", data.SiteId, data.SiteKind, data.SubscriptionId, webAppId)
+		}
+		if data.ScmBasicAuthEnabled == nil || *data.ScmBasicAuthEnabled || data.FtpBasicAuthEnabled == nil || !*data.FtpBasicAuthEnabled {
+			t.Errorf("got scm %v and ftp %v, want scm disabled and ftp enabled", data.ScmBasicAuthEnabled, data.FtpBasicAuthEnabled)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+// Well-known role ids that guestUserRoleId may be set to
+// See https://learn.microsoft.com/en-us/entra/identity/users/users-restrict-guest-permissions
+var guestAccessLevels = map[string]string{
+	"a0b1b346-4d3e-4e8b-98f8-753987be4970": "member",
+	"10dae51f-b6af-4016-8d66-8c2a99b929b3": "limited",
+	"2af84b1e-32c8-42b7-82bc-daa82404023b": "restricted",
+}
+
+func init() {
+	listRootCmd.AddCommand(listAuthorizationPolicyCmd)
+}
+
+var listAuthorizationPolicyCmd = &cobra.Command{
+	Use:          "authorization-policy",
+	Long:         "Lists the Azure AD Authorization Policy",
+	Run:          listAuthorizationPolicyCmdImpl,
+	SilenceUsage: true,
+}
+
+func listAuthorizationPolicyCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad authorization policy...")
+	start := time.Now()
+	stream := listAuthorizationPolicy(ctx, azClient)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listAuthorizationPolicy(ctx context.Context, client client.AzureClient) <-chan interface{} {
+	out := make(chan interface{})
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		if policy, err := client.GetAzureADAuthorizationPolicy(ctx); err != nil {
+			log.Error(err, "unable to continue processing authorization policy")
+		} else {
+			log.V(2).Info("found authorization policy", "policy", policy)
+			usersCanConsent := false
+			for _, grantPolicy := range policy.DefaultUserRolePermissions.PermissionGrantPoliciesAssigned {
+				if strings.HasPrefix(grantPolicy, "ManagePermissionGrantsForSelf.") {
+					usersCanConsent = true
+				}
+			}
+			if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+				Kind: enums.KindAZAuthorizationPolicy,
+				Data: models.AuthorizationPolicy{
+					AuthorizationPolicy: *policy,
+					GuestAccess:         guestAccessLevels[policy.GuestUserRoleId],
+					UsersCanConsent:     usersCanConsent,
+					TenantId:            client.TenantInfo().TenantId,
+					TenantName:          client.TenantInfo().DisplayName,
+				},
+			}); !ok {
+				return
+			}
+			log.Info("finished listing authorization policy")
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listAutomationAccountAssetsCmd)
+}
+
+var listAutomationAccountAssetsCmd = &cobra.Command{
+	Use:          "automation-account-assets",
+	Long:         "Lists the names of Azure Automation Account Credentials, Certificates, Connections and Variables",
+	Run:          listAutomationAccountAssetsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listAutomationAccountAssetsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure automation account assets...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listAutomationAccountAssets(ctx, azClient, listAutomationAccounts(ctx, azClient, subscriptions))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listAutomationAccountAssets(ctx context.Context, client client.AzureClient, automationAccounts <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), automationAccounts) {
+			if automationAccount, ok := result.(AzureWrapper).Data.(models.AutomationAccount); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating automation account assets", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, automationAccount.Id); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				assets := models.AutomationAccountAssets{
+					AutomationAccountId: id,
+					TenantId:            client.TenantInfo().TenantId,
+				}
+				for assetType, names := range map[enums.AutomationAssetType]*[]string{
+					enums.AutomationCertificates: &assets.Certificates,
+					enums.AutomationConnections:  &assets.Connections,
+					enums.AutomationCredentials:  &assets.Credentials,
+					enums.AutomationVariables:    &assets.Variables,
+				} {
+					for item := range client.ListAzureAutomationAssets(ctx, id, assetType, query.RMParams{}) {
+						if item.Error != nil {
+							log.Error(item.Error, "unable to continue processing assets for this automation account", "automationAccountId", id, "assetType", assetType)
+						} else {
+							*names = append(*names, item.Ok.Name)
+						}
+					}
+				}
+				log.V(2).Info("found automation account assets", "assets", assets)
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZAutomationAccountAssets,
+					Data: assets,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing automation account assets", "automationAccountId", id)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all automation account assets")
+	}()
+
+	return out
+}
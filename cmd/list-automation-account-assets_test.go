@@ -0,0 +1,90 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListAutomationAccountAssets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		accountId                     = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Automation/automationAccounts/baz"
+		mockAutomationAccountsChannel = make(chan interface{})
+	)
+
+	assets := func(names ...string) <-chan client.AzureResult[azure.AutomationAsset] {
+		out := make(chan client.AzureResult[azure.AutomationAsset])
+		go func() {
+			defer close(out)
+			for _, name := range names {
+				out <- client.AzureResult[azure.AutomationAsset]{Ok: azure.AutomationAsset{Name: name}}
+			}
+		}()
+		return out
+	}
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureAutomationAssets(gomock.Any(), accountId, enums.AutomationCertificates, query.RMParams{}).Return(assets()).Times(1)
+	mockClient.EXPECT().ListAzureAutomationAssets(gomock.Any(), accountId, enums.AutomationConnections, query.RMParams{}).Return(assets("AzureRunAsConnection")).Times(1)
+	mockClient.EXPECT().ListAzureAutomationAssets(gomock.Any(), accountId, enums.AutomationCredentials, query.RMParams{}).Return(assets("domain-admin", "sql")).Times(1)
+	mockClient.EXPECT().ListAzureAutomationAssets(gomock.Any(), accountId, enums.AutomationVariables, query.RMParams{}).Return(assets("endpoint")).Times(1)
+	channel := listAutomationAccountAssets(ctx, mockClient, mockAutomationAccountsChannel)
+
+	go func() {
+		defer close(mockAutomationAccountsChannel)
+		mockAutomationAccountsChannel <- AzureWrapper{
+			Kind: enums.KindAZAutomationAccount,
+			Data: models.AutomationAccount{
+				AutomationAccount: azure.AutomationAccount{Entity: azure.Entity{Id: accountId}},
+			},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.AutomationAccountAssets); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.AutomationAccountAssets{})
+	} else if len(data.Certificates) != 0 || len(data.Connections) != 1 || len(data.Credentials) != 2 || len(data.Variables) != 1 {
+		t.Errorf("got %v, want 0 certificates, 1 connection, 2 credentials and 1 variable", data)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listAutomationHybridWorkerGroupsCmd)
+}
+
+var listAutomationHybridWorkerGroupsCmd = &cobra.Command{
+	Use:          "automation-hybrid-worker-groups",
+	Long:         "Lists Azure Automation Hybrid Runbook Worker Groups and their Workers",
+	Run:          listAutomationHybridWorkerGroupsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listAutomationHybridWorkerGroupsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure automation hybrid worker groups...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listAutomationHybridWorkerGroups(ctx, azClient, listAutomationAccounts(ctx, azClient, subscriptions))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listAutomationHybridWorkerGroups lists the hybrid runbook worker groups of each automation account together with
+// the machines registered as their workers. Jobs sent to a group run on those machines, as the group's credential if
+// it has one and as the machine's local system otherwise.
+func listAutomationHybridWorkerGroups(ctx context.Context, client client.AzureClient, automationAccounts <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), automationAccounts) {
+			if automationAccount, ok := result.(AzureWrapper).Data.(models.AutomationAccount); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating automation hybrid worker groups", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, automationAccount.Id); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzureAutomationHybridWorkerGroups(ctx, id, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing hybrid worker groups for this automation account", "automationAccountId", id)
+					} else {
+						hybridWorkerGroup := models.AutomationHybridWorkerGroup{
+							AutomationHybridWorkerGroup: item.Ok,
+							AutomationAccountId:         id,
+							TenantId:                    client.TenantInfo().TenantId,
+						}
+						for worker := range client.ListAzureAutomationHybridWorkers(ctx, item.Ok.Id, query.RMParams{}) {
+							if worker.Error != nil {
+								log.Error(worker.Error, "unable to continue processing workers for this hybrid worker group", "hybridWorkerGroupId", item.Ok.Id)
+							} else {
+								hybridWorkerGroup.Workers = append(hybridWorkerGroup.Workers, worker.Ok)
+							}
+						}
+						log.V(2).Info("found automation hybrid worker group", "hybridWorkerGroup", hybridWorkerGroup)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZAutomationHybridWorkerGroup,
+							Data: hybridWorkerGroup,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing automation hybrid worker groups", "automationAccountId", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all automation hybrid worker groups")
+	}()
+
+	return out
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListAutomationHybridWorkerGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		accountId = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Automation/automationAccounts/baz"
+		groupId   = accountId + "/hybridRunbookWorkerGroups/group"
+
+		mockAutomationAccountsChannel = make(chan interface{})
+		mockGroupsChannel             = make(chan client.AzureResult[azure.AutomationHybridWorkerGroup])
+		mockWorkersChannel            = make(chan client.AzureResult[azure.AutomationHybridWorker])
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureAutomationHybridWorkerGroups(gomock.Any(), accountId, query.RMParams{}).Return(mockGroupsChannel).Times(1)
+	mockClient.EXPECT().ListAzureAutomationHybridWorkers(gomock.Any(), groupId, query.RMParams{}).Return(mockWorkersChannel).Times(1)
+	channel := listAutomationHybridWorkerGroups(ctx, mockClient, mockAutomationAccountsChannel)
+
+	go func() {
+		defer close(mockAutomationAccountsChannel)
+		mockAutomationAccountsChannel <- AzureWrapper{
+			Kind: enums.KindAZAutomationAccount,
+			Data: models.AutomationAccount{
+				AutomationAccount: azure.AutomationAccount{Entity: azure.Entity{Id: accountId}},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockGroupsChannel)
+		mockGroupsChannel <- client.AzureResult[azure.AutomationHybridWorkerGroup]{
+			Ok: azure.AutomationHybridWorkerGroup{Entity: azure.Entity{Id: groupId}},
+		}
+	}()
+	go func() {
+		defer close(mockWorkersChannel)
+		mockWorkersChannel <- client.AzureResult[azure.AutomationHybridWorker]{
+			Ok: azure.AutomationHybridWorker{Properties: azure.AutomationHybridWorkerProperties{VmResourceId: "vm1"}},
+		}
+		mockWorkersChannel <- client.AzureResult[azure.AutomationHybridWorker]{
+			Ok: azure.AutomationHybridWorker{Properties: azure.AutomationHybridWorkerProperties{VmResourceId: "vm2"}},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.AutomationHybridWorkerGroup); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.AutomationHybridWorkerGroup{})
+	} else if data.AutomationAccountId != accountId || len(data.Workers) != 2 {
+		t.Errorf("got %v, want a group of %s with 2 workers", data, accountId)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
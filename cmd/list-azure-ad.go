@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/spf13/cobra"
@@ -54,7 +55,7 @@ func listAzureADCmdImpl(cmd *cobra.Command, args []string) {
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure ad objects...")
 	start := time.Now()
-	stream := listAllAD(ctx, azClient)
+	stream := withGroupTransitiveMembers(ctx, azClient, listAllAD(ctx, azClient))
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
@@ -65,10 +66,13 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 	var (
 		devices  = make(chan interface{})
 		devices2 = make(chan interface{})
+		devices3 = make(chan interface{})
 
 		groups  = make(chan interface{})
 		groups2 = make(chan interface{})
 		groups3 = make(chan interface{})
+		groups4 = make(chan interface{})
+		groups5 = make(chan interface{})
 
 		o365groups  = make(chan interface{})
 		o365groups2 = make(chan interface{})
@@ -76,45 +80,70 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 
 		roles  = make(chan interface{})
 		roles2 = make(chan interface{})
+		roles3 = make(chan interface{})
 
 		servicePrincipals  = make(chan interface{})
 		servicePrincipals2 = make(chan interface{})
 		servicePrincipals3 = make(chan interface{})
+		servicePrincipals4 = make(chan interface{})
 
 		tenants = make(chan interface{})
+
+		users  = make(chan interface{})
+		users2 = make(chan interface{})
+
+		administrativeUnits  = make(chan interface{})
+		administrativeUnits2 = make(chan interface{})
+		administrativeUnits3 = make(chan interface{})
 	)
 
-	// Enumerate Apps, AppOwners and AppMembers
-	appChans := pipeline.TeeFixed(ctx.Done(), listApps(ctx, client), 2)
+	// Enumerate Apps, AppOwners, AppMembers and AppFederatedCredentials
+	appChans := pipeline.TeeFixed(ctx.Done(), listApps(ctx, client), 3)
 	apps := pipeline.ToAny(ctx.Done(), appChans[0])
 	appOwners := pipeline.ToAny(ctx.Done(), listAppOwners(ctx, client, appChans[1]))
+	appFederatedCredentials := pipeline.ToAny(ctx.Done(), listAppFederatedCredentials(ctx, client, appChans[2]))
 
 	// Enumerate Devices and DeviceOwners
-	pipeline.Tee(ctx.Done(), listDevices(ctx, client), devices, devices2)
+	pipeline.Tee(ctx.Done(), listDevices(ctx, client), devices, devices2, devices3)
 	deviceOwners := listDeviceOwners(ctx, client, devices2)
 
+	// Enumerate Intune Managed Devices, Role Assignments and Scope Tags
+	intuneManagedDevices := listIntuneManagedDevices(ctx, client, devices3)
+	intuneRoleAssignments := listIntuneRoleAssignments(ctx, client)
+	intuneRoleScopeTags := listIntuneRoleScopeTags(ctx, client)
+
 	// Enumerate Groups, GroupOwners and GroupMembers
-	pipeline.Tee(ctx.Done(), listGroups(ctx, client), groups, groups2, groups3)
+	pipeline.Tee(ctx.Done(), listGroups(ctx, client), groups, groups2, groups3, groups4, groups5)
 	groupOwners := listGroupOwners(ctx, client, groups2)
 	groupMembers := listGroupMembers(ctx, client, groups3)
 
+	// Enumerate PIM for Groups eligible and active memberships and ownerships
+	groupEligibilityScheduleInstances := listGroupEligibilityScheduleInstances(ctx, client, groups4)
+	groupAssignmentScheduleInstances := listGroupAssignmentScheduleInstances(ctx, client, groups5)
+
 	// Enumerate Microsoft 365 Groups, GroupOwners and GroupMembers
 	pipeline.Tee(ctx.Done(), listGroups365(ctx, client), o365groups, o365groups2, o365groups3)
 	group365Owners := listGroup365Owners(ctx, client, o365groups2)
 	group365Members := listGroup365Members(ctx, client, o365groups3)
 
 	// Enumerate ServicePrincipals and ServicePrincipalOwners
-	pipeline.Tee(ctx.Done(), listServicePrincipals(ctx, client), servicePrincipals, servicePrincipals2, servicePrincipals3)
+	pipeline.Tee(ctx.Done(), listServicePrincipals(ctx, client), servicePrincipals, servicePrincipals2, servicePrincipals3, servicePrincipals4)
 	servicePrincipalOwners := listServicePrincipalOwners(ctx, client, servicePrincipals2)
 
 	// Enumerate Tenants
 	pipeline.Tee(ctx.Done(), listTenants(ctx, client), tenants)
 
 	// Enumerate Users
-	users := listUsers(ctx, client)
+	pipeline.Tee(ctx.Done(), listUsers(ctx, client), users, users2)
+	var userAuthenticationMethods <-chan interface{}
+	if config.ColAuthMethods.Value().(bool) {
+		userAuthenticationMethods = listUserAuthenticationMethods(ctx, client, users2)
+	} else {
+		userAuthenticationMethods = pipeline.Filter(ctx.Done(), users2, func(interface{}) bool { return false })
+	}
 
 	// Enumerate Roles and RoleAssignments
-	pipeline.Tee(ctx.Done(), listRoles(ctx, client), roles, roles2)
+	pipeline.Tee(ctx.Done(), listRoles(ctx, client), roles, roles2, roles3)
 	roleAssignments := listRoleAssignments(ctx, client, roles2)
 
 	// Enumerate AppRoleAssignments
@@ -126,12 +155,34 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 	// Enumerate Role Management Policy Assignments
 	unifiedRoleManagementPolicyAssignments := listRoleAssignmentPolicies(ctx, client)
 
+	// Enumerate Administrative Units, AdministrativeUnitMembers and AdministrativeUnitScopedRoles
+	pipeline.Tee(ctx.Done(), listAdministrativeUnits(ctx, client), administrativeUnits, administrativeUnits2, administrativeUnits3)
+	administrativeUnitMembers := listAdministrativeUnitMembers(ctx, client, administrativeUnits2)
+	administrativeUnitScopedRoles := listAdministrativeUnitScopedRoles(ctx, client, administrativeUnits3)
+
+	// Enumerate delegated OAuth2 Permission Grants
+	oauth2PermissionGrants := listOAuth2PermissionGrants(ctx, client)
+
+	// Enumerate Conditional Access Policies and Named Locations
+	conditionalAccessPolicies := listConditionalAccessPolicies(ctx, client, servicePrincipals4, roles3)
+	namedLocations := listNamedLocations(ctx, client)
+
+	// Enumerate Cross-Tenant Access and Authorization Policies
+	crossTenantAccessConfigurations := listCrossTenantAccessConfigurations(ctx, client)
+	authorizationPolicy := listAuthorizationPolicy(ctx, client)
+
 	return pipeline.Mux(ctx.Done(),
+		appFederatedCredentials,
 		appOwners,
 		appRoleAssignments,
 		apps,
 		deviceOwners,
 		devices,
+		intuneManagedDevices,
+		intuneRoleAssignments,
+		intuneRoleScopeTags,
+		groupAssignmentScheduleInstances,
+		groupEligibilityScheduleInstances,
 		groupMembers,
 		groupOwners,
 		groups,
@@ -144,7 +195,16 @@ func listAllAD(ctx context.Context, client client.AzureClient) <-chan interface{
 		servicePrincipals,
 		tenants,
 		users,
+		userAuthenticationMethods,
 		unifiedRoleEligibilitySchedules,
 		unifiedRoleManagementPolicyAssignments,
+		conditionalAccessPolicies,
+		namedLocations,
+		crossTenantAccessConfigurations,
+		authorizationPolicy,
+		oauth2PermissionGrants,
+		administrativeUnits,
+		administrativeUnitMembers,
+		administrativeUnitScopedRoles,
 	)
 }
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
@@ -67,28 +68,47 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 	var (
 		functionApps  = make(chan interface{})
 		functionApps2 = make(chan interface{})
+		functionApps3 = make(chan interface{})
+		functionApps4 = make(chan interface{})
 
 		webApps  = make(chan interface{})
 		webApps2 = make(chan interface{})
+		webApps3 = make(chan interface{})
+		webApps4 = make(chan interface{})
+
+		appServiceSlots  = make(chan interface{})
+		appServiceSlots2 = make(chan interface{})
+		appServiceSlots3 = make(chan interface{})
 
 		automationAccounts  = make(chan interface{})
 		automationAccounts2 = make(chan interface{})
+		automationAccounts3 = make(chan interface{})
+		automationAccounts4 = make(chan interface{})
+		automationAccounts5 = make(chan interface{})
+		automationAccounts6 = make(chan interface{})
 
 		containerRegistries  = make(chan interface{})
 		containerRegistries2 = make(chan interface{})
+		containerRegistries3 = make(chan interface{})
 
 		logicApps  = make(chan interface{})
 		logicApps2 = make(chan interface{})
+		logicApps3 = make(chan interface{})
+		logicApps4 = make(chan interface{})
 
 		managedClusters  = make(chan interface{})
 		managedClusters2 = make(chan interface{})
+		managedClusters3 = make(chan interface{})
 
 		vmScaleSets  = make(chan interface{})
 		vmScaleSets2 = make(chan interface{})
+		vmScaleSets3 = make(chan interface{})
+		vmScaleSets4 = make(chan interface{})
 
 		keyVaults                = make(chan interface{})
 		keyVaults2               = make(chan interface{})
 		keyVaults3               = make(chan interface{})
+		keyVaults4               = make(chan interface{})
 		keyVaultRoleAssignments1 = make(chan azureWrapper[models.KeyVaultRoleAssignments])
 		keyVaultRoleAssignments2 = make(chan azureWrapper[models.KeyVaultRoleAssignments])
 		keyVaultRoleAssignments3 = make(chan azureWrapper[models.KeyVaultRoleAssignments])
@@ -97,11 +117,30 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		mgmtGroups                = make(chan interface{})
 		mgmtGroups2               = make(chan interface{})
 		mgmtGroups3               = make(chan interface{})
+		mgmtGroups4               = make(chan interface{})
+		mgmtGroups5               = make(chan interface{})
+		mgmtGroups6               = make(chan interface{})
 		mgmtGroupRoleAssignments1 = make(chan azureWrapper[models.ManagementGroupRoleAssignments])
 		mgmtGroupRoleAssignments2 = make(chan azureWrapper[models.ManagementGroupRoleAssignments])
 
+		storageAccounts                = make(chan interface{})
+		storageAccounts2               = make(chan interface{})
+		storageAccounts3               = make(chan interface{})
+		storageAccounts4               = make(chan interface{})
+		storageAccountRoleAssignments1 = make(chan azureWrapper[models.AzureRoleAssignments])
+		storageAccountRoleAssignments2 = make(chan azureWrapper[models.AzureRoleAssignments])
+		storageAccountRoleAssignments3 = make(chan azureWrapper[models.AzureRoleAssignments])
+		storageAccountRoleAssignments4 = make(chan azureWrapper[models.AzureRoleAssignments])
+
+		userAssignedIdentities  = make(chan interface{})
+		userAssignedIdentities2 = make(chan interface{})
+
+		roleDefinitions  = make(chan interface{})
+		roleDefinitions2 = make(chan interface{})
+
 		resourceGroups                = make(chan interface{})
 		resourceGroups2               = make(chan interface{})
+		resourceGroups3               = make(chan interface{})
 		resourceGroupRoleAssignments1 = make(chan azureWrapper[models.ResourceGroupRoleAssignments])
 		resourceGroupRoleAssignments2 = make(chan azureWrapper[models.ResourceGroupRoleAssignments])
 
@@ -117,11 +156,50 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		subscriptions10              = make(chan interface{})
 		subscriptions11              = make(chan interface{})
 		subscriptions12              = make(chan interface{})
+		subscriptions13              = make(chan interface{})
+		subscriptions14              = make(chan interface{})
+		subscriptions15              = make(chan interface{})
+		subscriptions16              = make(chan interface{})
+		subscriptions17              = make(chan interface{})
+		subscriptions18              = make(chan interface{})
+		subscriptions19              = make(chan interface{})
+		subscriptions20              = make(chan interface{})
+		subscriptions21              = make(chan interface{})
+		subscriptions22              = make(chan interface{})
+		subscriptions23              = make(chan interface{})
+		subscriptions24              = make(chan interface{})
+		subscriptions25              = make(chan interface{})
+		subscriptions26              = make(chan interface{})
+		subscriptions27              = make(chan interface{})
 		subscriptionRoleAssignments1 = make(chan interface{})
 		subscriptionRoleAssignments2 = make(chan interface{})
 
+		cosmosDBAccounts   = make(chan interface{})
+		cosmosDBAccounts2  = make(chan interface{})
+		cosmosDBAccounts3  = make(chan interface{})
+		dataFactories      = make(chan interface{})
+		dataFactories2     = make(chan interface{})
+		dataFactories3     = make(chan interface{})
+		sqlServers         = make(chan interface{})
+		sqlServers2        = make(chan interface{})
+		sqlServers3        = make(chan interface{})
+		synapseWorkspaces  = make(chan interface{})
+		synapseWorkspaces2 = make(chan interface{})
+		synapseWorkspaces3 = make(chan interface{})
+
+		publicIPAddresses      = make(chan interface{})
+		publicIPAddresses2     = make(chan interface{})
+		networkInterfaces      = make(chan interface{})
+		networkInterfaces2     = make(chan interface{})
+		networkSecurityGroups  = make(chan interface{})
+		networkSecurityGroups2 = make(chan interface{})
+		virtualNetworks        = make(chan interface{})
+		virtualNetworks2       = make(chan interface{})
+
 		virtualMachines                = make(chan interface{})
 		virtualMachines2               = make(chan interface{})
+		virtualMachines3               = make(chan interface{})
+		virtualMachines4               = make(chan interface{})
 		virtualMachineRoleAssignments1 = make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 		virtualMachineRoleAssignments2 = make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 		virtualMachineRoleAssignments3 = make(chan azureWrapper[models.VirtualMachineRoleAssignments])
@@ -130,7 +208,7 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 	)
 
 	// Enumerate entities
-	pipeline.Tee(ctx.Done(), listManagementGroups(ctx, client), mgmtGroups, mgmtGroups2, mgmtGroups3)
+	pipeline.Tee(ctx.Done(), listManagementGroups(ctx, client), mgmtGroups, mgmtGroups2, mgmtGroups3, mgmtGroups4, mgmtGroups5, mgmtGroups6)
 	pipeline.Tee(ctx.Done(), listSubscriptions(ctx, client),
 		subscriptions,
 		subscriptions2,
@@ -144,50 +222,101 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 		subscriptions10,
 		subscriptions11,
 		subscriptions12,
+		subscriptions13,
+		subscriptions14,
+		subscriptions15,
+		subscriptions16,
+		subscriptions17,
+		subscriptions18,
+		subscriptions19,
+		subscriptions20,
+		subscriptions21,
+		subscriptions22,
+		subscriptions23,
+		subscriptions24,
+		subscriptions25,
+		subscriptions26,
+		subscriptions27,
 	)
-	pipeline.Tee(ctx.Done(), listResourceGroups(ctx, client, subscriptions2), resourceGroups, resourceGroups2)
-	pipeline.Tee(ctx.Done(), listKeyVaults(ctx, client, subscriptions3), keyVaults, keyVaults2, keyVaults3)
-	pipeline.Tee(ctx.Done(), listVirtualMachines(ctx, client, subscriptions4), virtualMachines, virtualMachines2)
-	pipeline.Tee(ctx.Done(), listFunctionApps(ctx, client, subscriptions6), functionApps, functionApps2)
-	pipeline.Tee(ctx.Done(), listWebApps(ctx, client, subscriptions7), webApps, webApps2)
-	pipeline.Tee(ctx.Done(), listAutomationAccounts(ctx, client, subscriptions8), automationAccounts, automationAccounts2)
-	pipeline.Tee(ctx.Done(), listContainerRegistries(ctx, client, subscriptions9), containerRegistries, containerRegistries2)
-	pipeline.Tee(ctx.Done(), listLogicApps(ctx, client, subscriptions10), logicApps, logicApps2)
-	pipeline.Tee(ctx.Done(), listManagedClusters(ctx, client, subscriptions11), managedClusters, managedClusters2)
-	pipeline.Tee(ctx.Done(), listVMScaleSets(ctx, client, subscriptions12), vmScaleSets, vmScaleSets2)
+	pipeline.Tee(ctx.Done(), listResourceGroups(ctx, client, subscriptions2), resourceGroups, resourceGroups2, resourceGroups3)
+	pipeline.Tee(ctx.Done(), listKeyVaults(ctx, client, subscriptions3), keyVaults, keyVaults2, keyVaults3, keyVaults4)
+	pipeline.Tee(ctx.Done(), listVirtualMachines(ctx, client, subscriptions4), virtualMachines, virtualMachines2, virtualMachines3, virtualMachines4)
+	pipeline.Tee(ctx.Done(), listFunctionApps(ctx, client, subscriptions6), functionApps, functionApps2, functionApps3, functionApps4)
+	pipeline.Tee(ctx.Done(), listWebApps(ctx, client, subscriptions7), webApps, webApps2, webApps3, webApps4)
+	pipeline.Tee(ctx.Done(), listAutomationAccounts(ctx, client, subscriptions8), automationAccounts, automationAccounts2, automationAccounts3, automationAccounts4, automationAccounts5, automationAccounts6)
+	pipeline.Tee(ctx.Done(), listContainerRegistries(ctx, client, subscriptions9), containerRegistries, containerRegistries2, containerRegistries3)
+	pipeline.Tee(ctx.Done(), listLogicApps(ctx, client, subscriptions10), logicApps, logicApps2, logicApps3, logicApps4)
+	pipeline.Tee(ctx.Done(), listManagedClusters(ctx, client, subscriptions11), managedClusters, managedClusters2, managedClusters3)
+	pipeline.Tee(ctx.Done(), listVMScaleSets(ctx, client, subscriptions12), vmScaleSets, vmScaleSets2, vmScaleSets3, vmScaleSets4)
+	pipeline.Tee(ctx.Done(), listStorageAccounts(ctx, client, subscriptions17), storageAccounts, storageAccounts2, storageAccounts3, storageAccounts4)
+	pipeline.Tee(ctx.Done(), listPublicIPAddresses(ctx, client, subscriptions19), publicIPAddresses, publicIPAddresses2)
+	pipeline.Tee(ctx.Done(), listNetworkInterfaces(ctx, client, subscriptions20), networkInterfaces, networkInterfaces2)
+	pipeline.Tee(ctx.Done(), listNetworkSecurityGroups(ctx, client, subscriptions21), networkSecurityGroups, networkSecurityGroups2)
+	pipeline.Tee(ctx.Done(), listVirtualNetworks(ctx, client, subscriptions22), virtualNetworks, virtualNetworks2)
+	pipeline.Tee(ctx.Done(), listSqlServers(ctx, client, subscriptions23), sqlServers, sqlServers2, sqlServers3)
+	pipeline.Tee(ctx.Done(), listCosmosDBAccounts(ctx, client, subscriptions24), cosmosDBAccounts, cosmosDBAccounts2, cosmosDBAccounts3)
+	pipeline.Tee(ctx.Done(), listDataFactories(ctx, client, subscriptions25), dataFactories, dataFactories2, dataFactories3)
+	pipeline.Tee(ctx.Done(), listSynapseWorkspaces(ctx, client, subscriptions26), synapseWorkspaces, synapseWorkspaces2, synapseWorkspaces3)
+
+	// The ports virtual machines and scale sets expose to the internet are listed once the network resources have been
+	// collected
+	networkIndex := newNetworkIndex(ctx, publicIPAddresses2, networkInterfaces2, networkSecurityGroups2, virtualNetworks2)
+	vmNetworkExposure := listNetworkExposure(ctx, virtualMachines4, networkIndex)
+	vmScaleSetNetworkExposure := listNetworkExposure(ctx, vmScaleSets4, networkIndex)
+
+	// Role definitions are listed from their own scopes so that classifying role assignments never waits on the
+	// resource pipeline above
+	pipeline.Tee(ctx.Done(), listAllRoleDefinitions(ctx, client), roleDefinitions, roleDefinitions2)
+	roleIndex := newRoleDefinitionIndex(ctx, roleDefinitions2)
 
 	// Enumerate Relationships
 	// ManagementGroups: Descendants, Owners and UserAccessAdmins
 	mgmtGroupDescendants := listManagementGroupDescendants(ctx, client, mgmtGroups2)
 	pipeline.Tee(ctx.Done(), listManagementGroupRoleAssignments(ctx, client, mgmtGroups3), mgmtGroupRoleAssignments1, mgmtGroupRoleAssignments2)
-	mgmtGroupOwners := listManagementGroupOwners(ctx, mgmtGroupRoleAssignments1)
-	mgmtGroupUserAccessAdmins := listManagementGroupUserAccessAdmins(ctx, mgmtGroupRoleAssignments2)
+	mgmtGroupOwners := listManagementGroupOwners(ctx, mgmtGroupRoleAssignments1, roleIndex)
+	mgmtGroupUserAccessAdmins := listManagementGroupUserAccessAdmins(ctx, mgmtGroupRoleAssignments2, roleIndex)
 
 	// Subscriptions: Owners and UserAccessAdmins
 	pipeline.Tee(ctx.Done(), listSubscriptionRoleAssignments(ctx, client, subscriptions5), subscriptionRoleAssignments1, subscriptionRoleAssignments2)
-	subscriptionOwners := listSubscriptionOwners(ctx, client, subscriptionRoleAssignments1)
-	subscriptionUserAccessAdmins := listSubscriptionUserAccessAdmins(ctx, client, subscriptionRoleAssignments2)
+	subscriptionOwners := listSubscriptionOwners(ctx, client, subscriptionRoleAssignments1, roleIndex)
+	subscriptionUserAccessAdmins := listSubscriptionUserAccessAdmins(ctx, client, subscriptionRoleAssignments2, roleIndex)
 
 	// ResourceGroups: Owners and UserAccessAdmins
 	pipeline.Tee(ctx.Done(), listResourceGroupRoleAssignments(ctx, client, resourceGroups2), resourceGroupRoleAssignments1, resourceGroupRoleAssignments2)
-	resourceGroupOwners := listResourceGroupOwners(ctx, resourceGroupRoleAssignments1)
-	resourceGroupUserAccessAdmins := listResourceGroupUserAccessAdmins(ctx, resourceGroupRoleAssignments2)
+	resourceGroupOwners := listResourceGroupOwners(ctx, resourceGroupRoleAssignments1, roleIndex)
+	resourceGroupUserAccessAdmins := listResourceGroupUserAccessAdmins(ctx, resourceGroupRoleAssignments2, roleIndex)
 
 	// KeyVaults: AccessPolicies, Owners, UserAccessAdmins, Contributors and KVContributors
 	pipeline.Tee(ctx.Done(), listKeyVaultRoleAssignments(ctx, client, keyVaults2), keyVaultRoleAssignments1, keyVaultRoleAssignments2, keyVaultRoleAssignments3, keyVaultRoleAssignments4)
 	keyVaultAccessPolicies := listKeyVaultAccessPolicies(ctx, client, keyVaults3, []enums.KeyVaultAccessType{enums.GetCerts, enums.GetKeys, enums.GetCerts})
-	keyVaultOwners := listKeyVaultOwners(ctx, keyVaultRoleAssignments1)
-	keyVaultUserAccessAdmins := listKeyVaultUserAccessAdmins(ctx, keyVaultRoleAssignments2)
-	keyVaultContributors := listKeyVaultContributors(ctx, keyVaultRoleAssignments3)
-	keyVaultKVContributors := listKeyVaultKVContributors(ctx, keyVaultRoleAssignments4)
+	keyVaultOwners := listKeyVaultOwners(ctx, keyVaultRoleAssignments1, roleIndex)
+	keyVaultUserAccessAdmins := listKeyVaultUserAccessAdmins(ctx, keyVaultRoleAssignments2, roleIndex)
+	keyVaultContributors := listKeyVaultContributors(ctx, keyVaultRoleAssignments3, roleIndex)
+	keyVaultKVContributors := listKeyVaultKVContributors(ctx, keyVaultRoleAssignments4, roleIndex)
+
+	// Key vault contents are read from each vault's data plane and are only collected when asked for
+	var keyVaultContents <-chan interface{}
+	if config.ColKeyVaultContents.Value().(bool) {
+		keyVaultContents = listKeyVaultContents(ctx, client, keyVaults4)
+	} else {
+		keyVaultContents = pipeline.Filter(ctx.Done(), keyVaults4, func(interface{}) bool { return false })
+	}
 
 	// VirtualMachines: Owners, AvereContributors, Contributors, AdminLogins and UserAccessAdmins
 	pipeline.Tee(ctx.Done(), listVirtualMachineRoleAssignments(ctx, client, virtualMachines2), virtualMachineRoleAssignments1, virtualMachineRoleAssignments2, virtualMachineRoleAssignments3, virtualMachineRoleAssignments4, virtualMachineRoleAssignments5)
-	virtualMachineOwners := listVirtualMachineOwners(ctx, virtualMachineRoleAssignments1)
-	virtualMachineAvereContributors := listVirtualMachineAvereContributors(ctx, virtualMachineRoleAssignments2)
-	virtualMachineContributors := listVirtualMachineContributors(ctx, virtualMachineRoleAssignments3)
-	virtualMachineAdminLogins := listVirtualMachineAdminLogins(ctx, virtualMachineRoleAssignments4)
-	virtualMachineUserAccessAdmins := listVirtualMachineUserAccessAdmins(ctx, virtualMachineRoleAssignments5)
+	virtualMachineOwners := listVirtualMachineOwners(ctx, virtualMachineRoleAssignments1, roleIndex)
+	virtualMachineAvereContributors := listVirtualMachineAvereContributors(ctx, virtualMachineRoleAssignments2, roleIndex)
+	virtualMachineContributors := listVirtualMachineContributors(ctx, virtualMachineRoleAssignments3, roleIndex)
+	virtualMachineAdminLogins := listVirtualMachineAdminLogins(ctx, virtualMachineRoleAssignments4, roleIndex)
+	virtualMachineUserAccessAdmins := listVirtualMachineUserAccessAdmins(ctx, virtualMachineRoleAssignments5, roleIndex)
+
+	// StorageAccounts: Containers, Owners, Contributors, UserAccessAdmins and Storage Blob Data roles
+	storageContainers := listStorageContainers(ctx, client, storageAccounts3)
+	pipeline.Tee(ctx.Done(), listStorageAccountRoleAssignments(ctx, client, storageAccounts2), storageAccountRoleAssignments1, storageAccountRoleAssignments2, storageAccountRoleAssignments3, storageAccountRoleAssignments4)
+	storageAccountOwners := listStorageAccountOwners(ctx, storageAccountRoleAssignments1, roleIndex)
+	storageAccountContributors := listStorageAccountContributors(ctx, storageAccountRoleAssignments2, roleIndex)
+	storageAccountUserAccessAdmins := listStorageAccountUserAccessAdmins(ctx, storageAccountRoleAssignments3, roleIndex)
+	storageAccountBlobDataRoles := listStorageAccountBlobDataRoles(ctx, storageAccountRoleAssignments4, roleIndex)
 
 	// Enumerate Function App Role Assignments
 	functionAppRoleAssignments := listFunctionAppRoleAssignments(ctx, client, functionApps2)
@@ -195,54 +324,139 @@ func listAllRM(ctx context.Context, client client.AzureClient) <-chan interface{
 	// Enumerate Web App Role Assignments
 	webAppRoleAssignments := listWebAppRoleAssignments(ctx, client, webApps2)
 
+	// Enumerate Web App and Function App Deployment Slots and their Role Assignments
+	pipeline.Tee(ctx.Done(), listAppServiceSlots(ctx, client, webApps4, functionApps4), appServiceSlots, appServiceSlots2, appServiceSlots3)
+	appServiceSlotRoleAssignments := listAppServiceSlotRoleAssignments(ctx, client, appServiceSlots2)
+
 	// Enumerate Automation Account Role Assignments
 	automationAccountRoleAssignments := listAutomationAccountRoleAssignments(ctx, client, automationAccounts2)
 
+	// Enumerate Automation Runbooks, Hybrid Worker Groups and the names of Automation Account Assets
+	automationRunbooks := listAutomationRunbooks(ctx, client, automationAccounts4)
+	automationHybridWorkerGroups := listAutomationHybridWorkerGroups(ctx, client, automationAccounts5)
+	automationAccountAssets := listAutomationAccountAssets(ctx, client, automationAccounts6)
+
 	// Enumerate Container Registry Role Assignments
 	containerRegistryRoleAssignments := listContainerRegistryRoleAssignments(ctx, client, containerRegistries2)
 
 	// Enumerate Logic Apps Role Assignments
 	logicAppRoleAssignments := listLogicAppRoleAssignments(ctx, client, logicApps2)
 
-	// Enumerate Managed Cluster Role Assignments
-	managedClusterRoleAssignments := listManagedClusterRoleAssignments(ctx, client, managedClusters2)
+	// Enumerate API Connections and the Logic Apps that use them
+	apiConnections := listApiConnections(ctx, client, subscriptions18)
+	logicAppApiConnections := listLogicAppApiConnections(ctx, logicApps4)
+
+	// Enumerate Managed Cluster Role Assignments, Admins and Kubelet Identities
+	managedClusterRoleAssignments := listManagedClusterRoleAssignments(ctx, client, managedClusters2, roleIndex)
 
 	// Enumerate VM Scale Set Role Assignments
 	vmScaleSetRoleAssignments := listVMScaleSetRoleAssignments(ctx, client, vmScaleSets2)
 
+	// Enumerate Data Service Role Assignments
+	cosmosDBAccountRoleAssignments := listCosmosDBAccountRoleAssignments(ctx, client, cosmosDBAccounts2)
+	dataFactoryRoleAssignments := listDataFactoryRoleAssignments(ctx, client, dataFactories2)
+	sqlServerRoleAssignments := listSqlServerRoleAssignments(ctx, client, sqlServers2)
+	synapseWorkspaceRoleAssignments := listSynapseWorkspaceRoleAssignments(ctx, client, synapseWorkspaces2)
+
+	// Enumerate User-Assigned Managed Identities, their Federated Credentials and the Resources they are attached to
+	pipeline.Tee(ctx.Done(), listUserAssignedIdentities(ctx, client, subscriptions13), userAssignedIdentities, userAssignedIdentities2)
+	managedIdentityFederatedCredentials := listManagedIdentityFederatedCredentials(ctx, client, userAssignedIdentities2)
+	userAssignedIdentityAttachments := listUserAssignedIdentityAttachments(ctx, client,
+		appServiceSlots3,
+		automationAccounts3,
+		containerRegistries3,
+		cosmosDBAccounts3,
+		dataFactories3,
+		functionApps3,
+		logicApps3,
+		managedClusters3,
+		sqlServers3,
+		storageAccounts4,
+		synapseWorkspaces3,
+		virtualMachines3,
+		vmScaleSets3,
+		webApps3,
+	)
+
+	// Enumerate Azure RBAC PIM Eligibilities and Policies
+	resourceRoleEligibilityScheduleInstances := listResourceRoleEligibilityScheduleInstances(ctx, client, mgmtGroups4, subscriptions14)
+	resourceRoleManagementPolicyAssignments := listResourceRoleManagementPolicyAssignments(ctx, client, mgmtGroups5, subscriptions15, resourceGroups3)
+
+	// Enumerate Azure Lighthouse Delegations to Managing Tenants
+	lighthouseDelegations := listLighthouseDelegations(ctx, client, subscriptions27)
+
+	// Enumerate Policy Assignments and their Definitions
+	policyAssignments := listPolicyAssignments(ctx, client, mgmtGroups6, subscriptions16)
+
 	return pipeline.Mux(ctx.Done(),
+		apiConnections,
+		appServiceSlotRoleAssignments,
+		appServiceSlots,
+		automationAccountAssets,
 		automationAccounts,
 		automationAccountRoleAssignments,
+		automationHybridWorkerGroups,
+		automationRunbooks,
 		containerRegistries,
 		containerRegistryRoleAssignments,
+		cosmosDBAccountRoleAssignments,
+		cosmosDBAccounts,
+		dataFactories,
+		dataFactoryRoleAssignments,
 		functionApps,
 		functionAppRoleAssignments,
 		keyVaultAccessPolicies,
+		keyVaultContents,
 		keyVaultContributors,
 		keyVaultKVContributors,
 		keyVaultOwners,
 		keyVaultUserAccessAdmins,
 		keyVaults,
+		lighthouseDelegations,
+		logicAppApiConnections,
 		logicApps,
 		logicAppRoleAssignments,
 		managedClusters,
 		managedClusterRoleAssignments,
+		managedIdentityFederatedCredentials,
 		mgmtGroupDescendants,
 		mgmtGroupOwners,
 		mgmtGroupUserAccessAdmins,
 		mgmtGroups,
+		networkInterfaces,
+		networkSecurityGroups,
+		policyAssignments,
+		publicIPAddresses,
 		resourceGroupOwners,
 		resourceGroupUserAccessAdmins,
 		resourceGroups,
+		resourceRoleEligibilityScheduleInstances,
+		resourceRoleManagementPolicyAssignments,
+		roleDefinitions,
+		sqlServerRoleAssignments,
+		sqlServers,
+		storageAccountBlobDataRoles,
+		storageAccountContributors,
+		storageAccountOwners,
+		storageAccountUserAccessAdmins,
+		storageAccounts,
+		storageContainers,
 		subscriptionOwners,
 		subscriptionUserAccessAdmins,
 		subscriptions,
+		synapseWorkspaceRoleAssignments,
+		synapseWorkspaces,
+		userAssignedIdentities,
+		userAssignedIdentityAttachments,
 		virtualMachineAdminLogins,
 		virtualMachineAvereContributors,
 		virtualMachineContributors,
 		virtualMachineOwners,
 		virtualMachineUserAccessAdmins,
 		virtualMachines,
+		virtualNetworks,
+		vmNetworkExposure,
+		vmScaleSetNetworkExposure,
 		vmScaleSets,
 		vmScaleSetRoleAssignments,
 		webApps,
@@ -0,0 +1,184 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/gofrs/uuid"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listConditionalAccessPoliciesCmd)
+}
+
+var listConditionalAccessPoliciesCmd = &cobra.Command{
+	Use:          "conditional-access-policies",
+	Long:         "Lists Azure AD Conditional Access Policies",
+	Run:          listConditionalAccessPoliciesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listConditionalAccessPoliciesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad conditional access policies...")
+	start := time.Now()
+	stream := listConditionalAccessPolicies(ctx, azClient, listServicePrincipals(ctx, azClient), listRoles(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listConditionalAccessPolicies(ctx context.Context, client client.AzureClient, servicePrincipals <-chan interface{}, roles <-chan interface{}) <-chan interface{} {
+	var (
+		out       = make(chan interface{})
+		appIds    = make(map[string]string)
+		roleIds   = make(map[string]string)
+		lookupsWg sync.WaitGroup
+	)
+
+	// Build lookups used to resolve application client IDs and role template IDs to object IDs
+	lookupsWg.Add(2)
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer lookupsWg.Done()
+		for result := range pipeline.OrDone(ctx.Done(), servicePrincipals) {
+			if servicePrincipal, ok := result.(AzureWrapper).Data.(models.ServicePrincipal); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to resolve service principal for conditional access policies", "result", result)
+			} else {
+				appIds[servicePrincipal.AppId] = servicePrincipal.Id
+			}
+		}
+	}()
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer lookupsWg.Done()
+		for result := range pipeline.OrDone(ctx.Done(), roles) {
+			if role, ok := result.(AzureWrapper).Data.(models.Role); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to resolve role for conditional access policies", "result", result)
+			} else if role.TemplateId != "" {
+				roleIds[role.TemplateId] = role.Id
+			}
+		}
+	}()
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		lookupsWg.Wait()
+
+		count := 0
+		for item := range client.ListAzureADConditionalAccessPolicies(ctx, query.GraphParams{}) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing conditional access policies")
+				return
+			} else {
+				log.V(2).Info("found conditional access policy", "conditionalAccessPolicy", item)
+				count++
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZConditionalAccessPolicy,
+					Data: resolveConditionalAccessPolicy(item.Ok, appIds, roleIds, client.TenantInfo()),
+				}); !ok {
+					return
+				}
+			}
+		}
+		log.Info("finished listing all conditional access policies", "count", count)
+	}()
+
+	return out
+}
+
+// resolveConditionalAccessPolicy maps the user, group, role and application references of a conditional access policy to
+// directory object IDs, splitting out the keyword values (e.g. All, None, GuestsOrExternalUsers) that cannot be resolved
+func resolveConditionalAccessPolicy(policy azure.ConditionalAccessPolicy, appIds, roleIds map[string]string, tenant azure.Tenant) models.ConditionalAccessPolicy {
+	var (
+		users  = policy.Conditions.Users
+		apps   = policy.Conditions.Applications
+		result = models.ConditionalAccessPolicy{
+			ConditionalAccessPolicy: policy,
+			TenantId:                tenant.TenantId,
+			TenantName:              tenant.DisplayName,
+			IncludedGroups:          users.IncludeGroups,
+			ExcludedGroups:          users.ExcludeGroups,
+		}
+	)
+
+	result.IncludedUsers, result.IncludedUserKeywords = splitConditionalAccessKeywords(users.IncludeUsers)
+	result.ExcludedUsers, result.ExcludedUserKeywords = splitConditionalAccessKeywords(users.ExcludeUsers)
+	result.IncludedRoles = resolveConditionalAccessIds(users.IncludeRoles, roleIds)
+	result.ExcludedRoles = resolveConditionalAccessIds(users.ExcludeRoles, roleIds)
+
+	includedApps, includedAppKeywords := splitConditionalAccessKeywords(apps.IncludeApplications)
+	excludedApps, excludedAppKeywords := splitConditionalAccessKeywords(apps.ExcludeApplications)
+	result.IncludedApps = resolveConditionalAccessIds(includedApps, appIds)
+	result.ExcludedApps = resolveConditionalAccessIds(excludedApps, appIds)
+	result.IncludedAppKeywords = includedAppKeywords
+	result.ExcludedAppKeywords = excludedAppKeywords
+
+	return result
+}
+
+// splitConditionalAccessKeywords separates GUID references from keyword values
+func splitConditionalAccessKeywords(values []string) ([]string, []string) {
+	var (
+		ids      = []string{}
+		keywords = []string{}
+	)
+	for _, value := range values {
+		if _, err := uuid.FromString(value); err != nil {
+			keywords = append(keywords, value)
+		} else {
+			ids = append(ids, value)
+		}
+	}
+	return ids, keywords
+}
+
+// resolveConditionalAccessIds maps the provided IDs through the lookup, keeping IDs that cannot be resolved as-is
+func resolveConditionalAccessIds(values []string, lookup map[string]string) []string {
+	resolved := []string{}
+	for _, value := range values {
+		if id, ok := lookup[value]; ok {
+			resolved = append(resolved, id)
+		} else {
+			log.V(1).Info("unable to resolve conditional access policy reference to an object id", "id", value)
+			resolved = append(resolved, value)
+		}
+	}
+	return resolved
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListConditionalAccessPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	var (
+		appId          = "9a3b4e5c-0b2d-4f6e-8a7b-1c2d3e4f5a6b"
+		spId           = "1f2e3d4c-5b6a-4789-8a7b-6c5d4e3f2a1b"
+		roleTemplateId = "62e90394-69f5-4237-9190-012177145e10"
+		roleId         = "0d1e2f3a-4b5c-4d6e-8f7a-8b9c0d1e2f3a"
+		userId         = "c0ffee00-1234-4567-89ab-cdef01234567"
+	)
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockServicePrincipals := make(chan interface{})
+	mockRoles := make(chan interface{})
+	mockChannel := make(chan client.AzureResult[azure.ConditionalAccessPolicy])
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureADConditionalAccessPolicies(gomock.Any(), gomock.Any()).Return(mockChannel)
+
+	go func() {
+		defer close(mockServicePrincipals)
+		servicePrincipal := models.ServicePrincipal{}
+		servicePrincipal.Id = spId
+		servicePrincipal.AppId = appId
+		mockServicePrincipals <- AzureWrapper{Kind: enums.KindAZServicePrincipal, Data: servicePrincipal}
+	}()
+	go func() {
+		defer close(mockRoles)
+		role := models.Role{}
+		role.Id = roleId
+		role.TemplateId = roleTemplateId
+		mockRoles <- AzureWrapper{Kind: enums.KindAZRole, Data: role}
+	}()
+	go func() {
+		defer close(mockChannel)
+		policy := azure.ConditionalAccessPolicy{}
+		policy.Conditions.Users.IncludeUsers = []string{"All"}
+		policy.Conditions.Users.ExcludeUsers = []string{userId}
+		policy.Conditions.Users.ExcludeRoles = []string{roleTemplateId}
+		policy.Conditions.Applications.IncludeApplications = []string{appId, "Office365"}
+		mockChannel <- client.AzureResult[azure.ConditionalAccessPolicy]{
+			Ok: policy,
+		}
+		mockChannel <- client.AzureResult[azure.ConditionalAccessPolicy]{
+			Error: mockError,
+		}
+		mockChannel <- client.AzureResult[azure.ConditionalAccessPolicy]{
+			Ok: azure.ConditionalAccessPolicy{},
+		}
+	}()
+
+	channel := listConditionalAccessPolicies(ctx, mockClient, mockServicePrincipals, mockRoles)
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.ConditionalAccessPolicy); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.ConditionalAccessPolicy{})
+	} else {
+		if len(data.IncludedUserKeywords) != 1 || data.IncludedUserKeywords[0] != "All" {
+			t.Errorf("got %v, want %v", data.IncludedUserKeywords, []string{"All"})
+		}
+		if len(data.ExcludedUsers) != 1 || data.ExcludedUsers[0] != userId {
+			t.Errorf("got %v, want %v", data.ExcludedUsers, []string{userId})
+		}
+		if len(data.ExcludedRoles) != 1 || data.ExcludedRoles[0] != roleId {
+			t.Errorf("got %v, want %v", data.ExcludedRoles, []string{roleId})
+		}
+		if len(data.IncludedApps) != 1 || data.IncludedApps[0] != spId {
+			t.Errorf("got %v, want %v", data.IncludedApps, []string{spId})
+		}
+		if len(data.IncludedAppKeywords) != 1 || data.IncludedAppKeywords[0] != "Office365" {
+			t.Errorf("got %v, want %v", data.IncludedAppKeywords, []string{"Office365"})
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close from an error result but it did not")
+	}
+}
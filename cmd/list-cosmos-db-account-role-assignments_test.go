@@ -0,0 +1,115 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListCosmosDBAccountRoleAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockCosmosDBAccountsChannel := make(chan interface{})
+	mockCosmosDBAccountRoleAssignmentChannel := make(chan client.AzureResult[azure.RoleAssignment])
+	mockCosmosDBAccountRoleAssignmentChannel2 := make(chan client.AzureResult[azure.RoleAssignment])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListRoleAssignmentsForResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockCosmosDBAccountRoleAssignmentChannel).Times(1)
+	mockClient.EXPECT().ListRoleAssignmentsForResource(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(mockCosmosDBAccountRoleAssignmentChannel2).Times(1)
+	channel := listCosmosDBAccountRoleAssignments(ctx, mockClient, mockCosmosDBAccountsChannel)
+
+	go func() {
+		defer close(mockCosmosDBAccountsChannel)
+		mockCosmosDBAccountsChannel <- AzureWrapper{
+			Data: models.CosmosDBAccount{},
+		}
+		mockCosmosDBAccountsChannel <- AzureWrapper{
+			Data: models.CosmosDBAccount{},
+		}
+	}()
+	go func() {
+		defer close(mockCosmosDBAccountRoleAssignmentChannel)
+		mockCosmosDBAccountRoleAssignmentChannel <- client.AzureResult[azure.RoleAssignment]{
+			Ok: azure.RoleAssignment{
+				Properties: azure.RoleAssignmentPropertiesWithScope{
+					RoleDefinitionId: constants.OwnerRoleID,
+				},
+			},
+		}
+		mockCosmosDBAccountRoleAssignmentChannel <- client.AzureResult[azure.RoleAssignment]{
+			Ok: azure.RoleAssignment{
+				Properties: azure.RoleAssignmentPropertiesWithScope{
+					RoleDefinitionId: constants.ContributorRoleID,
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockCosmosDBAccountRoleAssignmentChannel2)
+		mockCosmosDBAccountRoleAssignmentChannel2 <- client.AzureResult[azure.RoleAssignment]{
+			Ok: azure.RoleAssignment{
+				Properties: azure.RoleAssignmentPropertiesWithScope{
+					RoleDefinitionId: constants.OwnerRoleID,
+				},
+			},
+		}
+		mockCosmosDBAccountRoleAssignmentChannel2 <- client.AzureResult[azure.RoleAssignment]{
+			Error: mockError,
+		}
+	}()
+
+	counts := map[int]bool{}
+	for i := 0; i < 2; i++ {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if data, ok := wrapper.Data.(models.AzureRoleAssignments); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.AzureRoleAssignments{})
+		} else {
+			counts[len(data.RoleAssignments)] = true
+		}
+	}
+
+	if !counts[1] || !counts[2] {
+		t.Errorf("got role assignment counts %v, want 1 and 2", counts)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listCrossTenantAccessConfigurationsCmd)
+}
+
+var listCrossTenantAccessConfigurationsCmd = &cobra.Command{
+	Use:          "cross-tenant-access-configurations",
+	Long:         "Lists Azure AD Cross-Tenant Access Default and Partner Configurations",
+	Run:          listCrossTenantAccessConfigurationsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listCrossTenantAccessConfigurationsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad cross-tenant access configurations...")
+	start := time.Now()
+	stream := listCrossTenantAccessConfigurations(ctx, azClient)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listCrossTenantAccessConfigurations(ctx context.Context, client client.AzureClient) <-chan interface{} {
+	out := make(chan interface{})
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		// Partner configurations only hold the settings that differ from the default, so the default is
+		// needed to resolve what actually applies to each partner tenant
+		defaults, err := client.GetAzureADCrossTenantAccessPolicyDefault(ctx)
+		if err != nil {
+			log.Error(err, "unable to continue processing cross-tenant access configurations")
+			return
+		} else if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+			Kind: enums.KindAZCrossTenantAccessConfiguration,
+			Data: newCrossTenantAccessConfiguration(client, *defaults, azure.CrossTenantAccessPolicyConfiguration{}, true),
+		}); !ok {
+			return
+		}
+
+		count := 0
+		for item := range client.ListAzureADCrossTenantAccessPolicyPartners(ctx, query.GraphParams{}) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing cross-tenant access partner configurations")
+				return
+			} else {
+				log.V(2).Info("found cross-tenant access partner configuration", "partner", item)
+				count++
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZCrossTenantAccessConfiguration,
+					Data: newCrossTenantAccessConfiguration(client, *defaults, item.Ok, false),
+				}); !ok {
+					return
+				}
+			}
+		}
+		log.Info("finished listing all cross-tenant access partner configurations", "count", count)
+	}()
+
+	return out
+}
+
+func newCrossTenantAccessConfiguration(client client.AzureClient, defaults, partner azure.CrossTenantAccessPolicyConfiguration, isDefault bool) models.CrossTenantAccessConfiguration {
+	configuration := defaults
+	if !isDefault {
+		configuration = partner
+	}
+
+	result := models.CrossTenantAccessConfiguration{
+		CrossTenantAccessPolicyConfiguration: configuration,
+		PartnerTenantId:                      partner.TenantId,
+		IsDefault:                            isDefault,
+		TenantId:                             client.TenantInfo().TenantId,
+		TenantName:                           client.TenantInfo().DisplayName,
+	}
+
+	if trust := inherit(partner.InboundTrust, defaults.InboundTrust); trust != nil {
+		result.MfaTrusted = trust.IsMfaAccepted
+		result.CompliantDeviceTrusted = trust.IsCompliantDeviceAccepted
+		result.HybridJoinedDeviceTrusted = trust.IsHybridAzureADJoinedDeviceAccepted
+	}
+	if setting := inherit(partner.B2BCollaborationInbound, defaults.B2BCollaborationInbound); setting != nil {
+		result.B2BCollaborationInboundAllowed = setting.AllowsAny()
+	}
+	if setting := inherit(partner.B2BDirectConnectInbound, defaults.B2BDirectConnectInbound); setting != nil {
+		result.B2BDirectConnectInboundAllowed = setting.AllowsAny()
+	}
+
+	return result
+}
+
+// inherit returns the partner-specific value when one is configured and the default otherwise.
+func inherit[T any](partner, defaults *T) *T {
+	if partner != nil {
+		return partner
+	}
+	return defaults
+}
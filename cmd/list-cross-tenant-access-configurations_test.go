@@ -0,0 +1,100 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListCrossTenantAccessConfigurations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockChannel = make(chan client.AzureResult[azure.CrossTenantAccessPolicyConfiguration])
+		defaults    = azure.CrossTenantAccessPolicyConfiguration{
+			InboundTrust: &azure.CrossTenantAccessPolicyInboundTrust{IsMfaAccepted: true},
+			B2BCollaborationInbound: &azure.CrossTenantAccessPolicyB2BSetting{
+				UsersAndGroups: &azure.CrossTenantAccessPolicyTargetConfiguration{
+					AccessType: "blocked",
+					Targets:    []azure.CrossTenantAccessPolicyTarget{{Target: "AllUsers", TargetType: "user"}},
+				},
+			},
+		}
+		partner = azure.CrossTenantAccessPolicyConfiguration{
+			TenantId: "partner-tenant",
+			B2BCollaborationInbound: &azure.CrossTenantAccessPolicyB2BSetting{
+				UsersAndGroups: &azure.CrossTenantAccessPolicyTargetConfiguration{
+					AccessType: "allowed",
+					Targets:    []azure.CrossTenantAccessPolicyTarget{{Target: "some-group", TargetType: "group"}},
+				},
+			},
+		}
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{TenantId: "our-tenant"}).AnyTimes()
+	mockClient.EXPECT().GetAzureADCrossTenantAccessPolicyDefault(gomock.Any()).Return(&defaults, nil).Times(1)
+	mockClient.EXPECT().ListAzureADCrossTenantAccessPolicyPartners(gomock.Any(), query.GraphParams{}).Return(mockChannel).Times(1)
+	channel := listCrossTenantAccessConfigurations(ctx, mockClient)
+
+	go func() {
+		defer close(mockChannel)
+		mockChannel <- client.AzureResult[azure.CrossTenantAccessPolicyConfiguration]{Ok: partner}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.CrossTenantAccessConfiguration); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.CrossTenantAccessConfiguration{})
+	} else if !data.IsDefault || !data.MfaTrusted || data.B2BCollaborationInboundAllowed {
+		t.Errorf("got %v, want a default configuration trusting mfa and blocking b2b collaboration", data)
+	}
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.CrossTenantAccessConfiguration); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.CrossTenantAccessConfiguration{})
+	} else if data.IsDefault || data.PartnerTenantId != "partner-tenant" || data.TenantId != "our-tenant" {
+		t.Errorf("got %v, want a partner configuration for partner-tenant", data)
+	} else if !data.MfaTrusted || !data.B2BCollaborationInboundAllowed {
+		t.Errorf("got %v, want inherited mfa trust and allowed b2b collaboration", data)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -91,12 +91,15 @@ func listDeviceOwners(ctx context.Context, client client.AzureClient, devices <-
 			defer panicrecovery.PanicRecovery()
 			defer wg.Done()
 			for id := range stream {
-				var (
-					data = models.DeviceOwners{
-						DeviceId: id,
-					}
-					count = 0
-				)
+				chunks := newRecordChunks(func(items []models.DeviceOwner) bool {
+					return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZDeviceOwner,
+						Data: models.DeviceOwners{
+							DeviceId: id,
+							Owners:   items,
+						},
+					})
+				})
 				for item := range client.ListAzureDeviceRegisteredOwners(ctx, id, query.GraphParams{}) {
 					if item.Error != nil {
 						log.Error(item.Error, "unable to continue processing owners for this device", "deviceId", id)
@@ -106,17 +109,15 @@ func listDeviceOwners(ctx context.Context, client client.AzureClient, devices <-
 							DeviceId: id,
 						}
 						log.V(2).Info("found device owner", "deviceOwner", deviceOwner)
-						count++
-						data.Owners = append(data.Owners, deviceOwner)
+						if ok := chunks.add(deviceOwner); !ok {
+							return
+						}
 					}
 				}
-				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
-					Kind: enums.KindAZDeviceOwner,
-					Data: data,
-				}); !ok {
+				if ok := chunks.flush(); !ok {
 					return
 				}
-				log.V(1).Info("finished listing device owners", "deviceId", id, "count", count)
+				log.V(1).Info("finished listing device owners", "deviceId", id, "count", chunks.count)
 			}
 		}()
 	}
@@ -125,5 +125,5 @@ func listFunctionApps(ctx context.Context, client client.AzureClient, subscripti
 		log.Info("finished listing all function apps")
 	}()
 
-	return out
+	return withBasicPublishingCredentialsPolicies(ctx, client, out)
 }
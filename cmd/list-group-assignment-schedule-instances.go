@@ -0,0 +1,70 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listGroupAssignmentScheduleInstancesCmd)
+}
+
+var listGroupAssignmentScheduleInstancesCmd = &cobra.Command{
+	Use:          "group-assignment-schedule-instances",
+	Long:         "Lists Azure AD PIM for Groups Assignment Schedule Instances",
+	Run:          listGroupAssignmentScheduleInstancesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listGroupAssignmentScheduleInstancesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad group assignment schedule instances...")
+	start := time.Now()
+	stream := listGroupAssignmentScheduleInstances(ctx, azClient, listGroups(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listGroupAssignmentScheduleInstances lists the PIM for Groups assignment schedule instances of role-assignable groups
+func listGroupAssignmentScheduleInstances(ctx context.Context, client client.AzureClient, groups <-chan interface{}) <-chan interface{} {
+	return listGroupScheduleInstances(ctx, client, groups, enums.KindAZGroupAssignmentInstance, client.ListAzureADGroupAssignmentScheduleInstances,
+		func(groupId string, instances []azure.PrivilegedAccessGroupAssignmentScheduleInstance) interface{} {
+			return models.GroupAssignmentScheduleInstances{
+				AssignmentScheduleInstances: instances,
+				GroupId:                     groupId,
+				TenantId:                    client.TenantInfo().TenantId,
+			}
+		})
+}
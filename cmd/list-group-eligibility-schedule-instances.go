@@ -0,0 +1,140 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listGroupEligibilityScheduleInstancesCmd)
+}
+
+var listGroupEligibilityScheduleInstancesCmd = &cobra.Command{
+	Use:          "group-eligibility-schedule-instances",
+	Long:         "Lists Azure AD PIM for Groups Eligibility Schedule Instances",
+	Run:          listGroupEligibilityScheduleInstancesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listGroupEligibilityScheduleInstancesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad group eligibility schedule instances...")
+	start := time.Now()
+	stream := listGroupEligibilityScheduleInstances(ctx, azClient, listGroups(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listGroupEligibilityScheduleInstances lists the PIM for Groups eligibility schedule instances of role-assignable groups
+func listGroupEligibilityScheduleInstances(ctx context.Context, client client.AzureClient, groups <-chan interface{}) <-chan interface{} {
+	return listGroupScheduleInstances(ctx, client, groups, enums.KindAZGroupEligibilityInstance, client.ListAzureADGroupEligibilityScheduleInstances,
+		func(groupId string, instances []azure.PrivilegedAccessGroupEligibilityScheduleInstance) interface{} {
+			return models.GroupEligibilityScheduleInstances{
+				EligibilityScheduleInstances: instances,
+				GroupId:                      groupId,
+				TenantId:                     client.TenantInfo().TenantId,
+			}
+		})
+}
+
+// listGroupScheduleInstances lists the PIM for Groups schedule instances of each role-assignable group with list and
+// emits them as a single record of the given kind per group
+func listGroupScheduleInstances[T any](ctx context.Context, client client.AzureClient, groups <-chan interface{}, kind enums.Kind, list func(context.Context, query.GraphParams) <-chan client.AzureResult[T], newRecord func(groupId string, instances []T) interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), groups) {
+			if group, ok := result.(AzureWrapper).Data.(models.Group); !ok {
+				log.Error(fmt.Errorf("failed group type assertion"), "unable to enumerate group schedule instances for this item", "kind", kind, "result", result)
+				continue
+			} else if group.IsAssignableToRole {
+				if ok := pipeline.Send(ctx.Done(), ids, group.Id); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				var (
+					instances []T
+					params    = query.GraphParams{Filter: fmt.Sprintf("groupId eq '%s'", id)}
+				)
+				for item := range list(ctx, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing schedule instances for this group", "kind", kind, "groupId", id)
+					} else {
+						log.V(2).Info("found group schedule instance", "kind", kind, "scheduleInstance", item.Ok)
+						instances = append(instances, item.Ok)
+					}
+				}
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: kind,
+					Data: newRecord(id, instances),
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing group schedule instances", "kind", kind, "groupId", id, "count", len(instances))
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing schedule instances for all groups", "kind", kind)
+	}()
+
+	return out
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListGroupEligibilityScheduleInstances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockGroupsChannel := make(chan interface{})
+	mockInstancesChannel := make(chan client.AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance])
+
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureADGroupEligibilityScheduleInstances(gomock.Any(), query.GraphParams{Filter: "groupId eq 'roleAssignable'"}).Return(mockInstancesChannel).Times(1)
+	channel := listGroupEligibilityScheduleInstances(ctx, mockClient, mockGroupsChannel)
+
+	go func() {
+		defer close(mockGroupsChannel)
+		roleAssignable := models.Group{}
+		roleAssignable.Id = "roleAssignable"
+		roleAssignable.IsAssignableToRole = true
+		mockGroupsChannel <- AzureWrapper{
+			Data: roleAssignable,
+		}
+		notRoleAssignable := models.Group{}
+		notRoleAssignable.Id = "notRoleAssignable"
+		mockGroupsChannel <- AzureWrapper{
+			Data: notRoleAssignable,
+		}
+	}()
+	go func() {
+		defer close(mockInstancesChannel)
+		instance := azure.PrivilegedAccessGroupEligibilityScheduleInstance{}
+		instance.AccessId = "owner"
+		instance.EndDateTime = "2026-12-31T00:00:00Z"
+		mockInstancesChannel <- client.AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance]{
+			Ok: instance,
+		}
+		mockInstancesChannel <- client.AzureResult[azure.PrivilegedAccessGroupEligibilityScheduleInstance]{
+			Error: mockError,
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.GroupEligibilityScheduleInstances); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.GroupEligibilityScheduleInstances{})
+	} else if len(data.EligibilityScheduleInstances) != 1 {
+		t.Errorf("got %v, want %v", len(data.EligibilityScheduleInstances), 1)
+	} else if instance := data.EligibilityScheduleInstances[0]; instance.AccessId != "owner" || instance.EndDateTime == "" {
+		t.Errorf("expected access type and time bounds to be preserved, got %+v", instance)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
@@ -102,12 +102,15 @@ func listGroupMembers(ctx context.Context, client client.AzureClient, groups <-c
 			defer panicrecovery.PanicRecovery()
 			defer wg.Done()
 			for id := range stream {
-				var (
-					data = models.GroupMembers{
-						GroupId: id,
-					}
-					count = 0
-				)
+				chunks := newRecordChunks(func(items []models.GroupMember) bool {
+					return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZGroupMember,
+						Data: models.GroupMembers{
+							GroupId: id,
+							Members: items,
+						},
+					})
+				})
 				for item := range client.ListAzureADGroupMembers(ctx, id, params) {
 					if item.Error != nil {
 						log.Error(item.Error, "unable to continue processing members for this group", "groupId", id)
@@ -117,17 +120,15 @@ func listGroupMembers(ctx context.Context, client client.AzureClient, groups <-c
 							GroupId: id,
 						}
 						log.V(2).Info("found group member", "groupMember", groupMember)
-						count++
-						data.Members = append(data.Members, groupMember)
+						if ok := chunks.add(groupMember); !ok {
+							return
+						}
 					}
 				}
-				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
-					Kind: enums.KindAZGroupMember,
-					Data: data,
-				}); !ok {
+				if ok := chunks.flush(); !ok {
 					return
 				}
-				log.V(1).Info("finished listing group memberships", "groupId", id, "count", count)
+				log.V(1).Info("finished listing group memberships", "groupId", id, "count", chunks.count)
 			}
 		}()
 	}
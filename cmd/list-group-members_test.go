@@ -100,3 +100,47 @@ func TestListGroupMembers(t *testing.T) {
 		t.Errorf("got %v, want %v", len(data.Members), 1)
 	}
 }
+
+func TestListGroupMembers_Chunked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockGroupsChannel := make(chan interface{})
+	mockGroupMemberChannel := make(chan client.AzureResult[json.RawMessage])
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureADGroupMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockGroupMemberChannel).Times(1)
+	channel := listGroupMembers(ctx, mockClient, mockGroupsChannel)
+
+	go func() {
+		defer close(mockGroupsChannel)
+		mockGroupsChannel <- AzureWrapper{
+			Data: models.Group{},
+		}
+	}()
+	go func() {
+		defer close(mockGroupMemberChannel)
+		for i := 0; i < recordChunkSize+1; i++ {
+			mockGroupMemberChannel <- client.AzureResult[json.RawMessage]{
+				Ok: json.RawMessage{},
+			}
+		}
+	}()
+
+	for _, want := range []int{recordChunkSize, 1} {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if data, ok := result.(AzureWrapper).Data.(models.GroupMembers); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.GroupMembers{})
+		} else if len(data.Members) != want {
+			t.Errorf("got %v, want %v", len(data.Members), want)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -102,12 +102,15 @@ func listGroup365Members(ctx context.Context, client client.AzureClient, groups
 			defer panicrecovery.PanicRecovery()
 			defer wg.Done()
 			for id := range stream {
-				var (
-					data = models.Group365Members{
-						GroupId: id,
-					}
-					count = 0
-				)
+				chunks := newRecordChunks(func(items []models.Group365Member) bool {
+					return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZGroup365Member,
+						Data: models.Group365Members{
+							GroupId: id,
+							Members: items,
+						},
+					})
+				})
 				for item := range client.ListAzureADGroup365Members(ctx, id, params) {
 					if item.Error != nil {
 						log.Error(item.Error, "unable to continue processing members for this Microsoft 365 group", "groupId", id)
@@ -117,17 +120,15 @@ func listGroup365Members(ctx context.Context, client client.AzureClient, groups
 							GroupId: id,
 						}
 						log.V(2).Info("found group Microsoft 365 member", "groupMember", group365Member)
-						count++
-						data.Members = append(data.Members, group365Member)
+						if ok := chunks.add(group365Member); !ok {
+							return
+						}
 					}
 				}
-				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
-					Kind: enums.KindAZGroup365Member,
-					Data: data,
-				}); !ok {
+				if ok := chunks.flush(); !ok {
 					return
 				}
-				log.V(1).Info("finished listing group memberships", "groupId", id, "count", count)
+				log.V(1).Info("finished listing group memberships", "groupId", id, "count", chunks.count)
 			}
 		}()
 	}
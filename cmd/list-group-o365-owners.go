@@ -92,12 +92,15 @@ func listGroup365Owners(ctx context.Context, client client.AzureClient, groups <
 			defer panicrecovery.PanicRecovery()
 			defer wg.Done()
 			for id := range stream {
-				var (
-					groupOwners = models.Group365Owners{
-						GroupId: id,
-					}
-					count = 0
-				)
+				chunks := newRecordChunks(func(items []models.Group365Owner) bool {
+					return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZGroup365Owner,
+						Data: models.Group365Owners{
+							GroupId: id,
+							Owners:  items,
+						},
+					})
+				})
 				for item := range client.ListAzureADGroup365Owners(ctx, id, params) {
 					if item.Error != nil {
 						log.Error(item.Error, "unable to continue processing owners for this Microsoft 365 group", "groupId", id)
@@ -107,17 +110,15 @@ func listGroup365Owners(ctx context.Context, client client.AzureClient, groups <
 							GroupId: id,
 						}
 						log.V(2).Info("found Microsoft 365 group owner", "groupOwner", groupOwner)
-						count++
-						groupOwners.Owners = append(groupOwners.Owners, groupOwner)
+						if ok := chunks.add(groupOwner); !ok {
+							return
+						}
 					}
 				}
-				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
-					Kind: enums.KindAZGroup365Owner,
-					Data: groupOwners,
-				}); !ok {
+				if ok := chunks.flush(); !ok {
 					return
 				}
-				log.V(1).Info("finished listing Microsoft 365 group owners", "groupId", id, "count", count)
+				log.V(1).Info("finished listing Microsoft 365 group owners", "groupId", id, "count", chunks.count)
 			}
 		}()
 	}
@@ -92,12 +92,15 @@ func listGroupOwners(ctx context.Context, client client.AzureClient, groups <-ch
 			defer panicrecovery.PanicRecovery()
 			defer wg.Done()
 			for id := range stream {
-				var (
-					groupOwners = models.GroupOwners{
-						GroupId: id,
-					}
-					count = 0
-				)
+				chunks := newRecordChunks(func(items []models.GroupOwner) bool {
+					return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZGroupOwner,
+						Data: models.GroupOwners{
+							GroupId: id,
+							Owners:  items,
+						},
+					})
+				})
 				for item := range client.ListAzureADGroupOwners(ctx, id, params) {
 					if item.Error != nil {
 						log.Error(item.Error, "unable to continue processing owners for this group", "groupId", id)
@@ -107,17 +110,15 @@ func listGroupOwners(ctx context.Context, client client.AzureClient, groups <-ch
 							GroupId: id,
 						}
 						log.V(2).Info("found group owner", "groupOwner", groupOwner)
-						count++
-						groupOwners.Owners = append(groupOwners.Owners, groupOwner)
+						if ok := chunks.add(groupOwner); !ok {
+							return
+						}
 					}
 				}
-				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
-					Kind: enums.KindAZGroupOwner,
-					Data: groupOwners,
-				}); !ok {
+				if ok := chunks.flush(); !ok {
 					return
 				}
-				log.V(1).Info("finished listing group owners", "groupId", id, "count", count)
+				log.V(1).Info("finished listing group owners", "groupId", id, "count", chunks.count)
 			}
 		}()
 	}
@@ -0,0 +1,371 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listGroupTransitiveMembersCmd)
+}
+
+var listGroupTransitiveMembersCmd = &cobra.Command{
+	Use:          "group-transitive-members",
+	Long:         "Lists the Transitive Members of privileged Azure AD Groups and the Transitive Memberships of their users",
+	Run:          listGroupTransitiveMembersCmdImpl,
+	SilenceUsage: true,
+}
+
+func listGroupTransitiveMembersCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure group transitive members...")
+	start := time.Now()
+	sources := pipeline.Mux(ctx.Done(), listGroups(ctx, azClient), listRoleAssignments(ctx, azClient, listRoles(ctx, azClient)))
+	stream := listTransitiveMemberships(ctx, azClient, sources)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// withGroupTransitiveMembers passes the stream through unchanged and, when transitive member collection is
+// enabled, follows it with the transitive memberships of the privileged groups found in it.
+func withGroupTransitiveMembers(ctx context.Context, client client.AzureClient, stream <-chan interface{}) <-chan interface{} {
+	if !config.ColTransitiveMembers.Value().(bool) {
+		return stream
+	}
+
+	var (
+		passthrough = make(chan interface{})
+		sources     = make(chan interface{})
+	)
+	pipeline.Tee(ctx.Done(), stream, passthrough, sources)
+	return pipeline.Mux(ctx.Done(), passthrough, listTransitiveMemberships(ctx, client, sources))
+}
+
+// listTransitiveMemberships lists the transitive members of the privileged groups found in sources, followed by the
+// transitive memberships of each user among those members.
+func listTransitiveMemberships(ctx context.Context, client client.AzureClient, sources <-chan interface{}) <-chan interface{} {
+	var (
+		groupMembers = make(chan interface{})
+		users        = make(chan interface{})
+	)
+	pipeline.Tee(ctx.Done(), listGroupTransitiveMembers(ctx, client, sources), groupMembers, users)
+	return pipeline.Mux(ctx.Done(), groupMembers, listUserTransitiveMemberOf(ctx, client, users))
+}
+
+// listGroupTransitiveMembers reads the whole sources stream before listing members, since a group is only known to
+// be privileged once every role assignment and owner referencing it has been seen.
+func listGroupTransitiveMembers(ctx context.Context, client client.AzureClient, sources <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		params  = query.GraphParams{Select: []string{"id", "displayName"}}
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for _, id := range privilegedGroups(ctx, sources) {
+			if ok := pipeline.Send(ctx.Done(), ids, id); !ok {
+				return
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				var (
+					depths = newMembershipDepths(ctx, client, id)
+					chunks = newRecordChunks(func(items []models.GroupTransitiveMember) bool {
+						return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZGroupTransitiveMember,
+							Data: models.GroupTransitiveMembers{
+								GroupId: id,
+								Members: items,
+							},
+						})
+					})
+				)
+				for item := range client.ListAzureADGroupTransitiveMembers(ctx, id, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing transitive members for this group", "groupId", id)
+					} else if member, err := newDirectoryObjectRef(item.Ok); err != nil {
+						log.Error(err, "unable to parse transitive member", "groupId", id)
+					} else {
+						log.V(2).Info("found group transitive member", "groupId", id, "member", member.Id)
+						if ok := chunks.add(models.GroupTransitiveMember{
+							Member:  member.raw,
+							GroupId: id,
+							Depth:   depths.of(member.Id),
+						}); !ok {
+							return
+						}
+					}
+				}
+
+				if ok := chunks.flush(); !ok {
+					return
+				}
+				log.V(1).Info("finished listing group transitive members", "groupId", id, "count", chunks.count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all group transitive members")
+	}()
+
+	return out
+}
+
+// listUserTransitiveMemberOf lists the groups, directory roles and administrative units each user found in the group
+// transitive member records belongs to, directly or through nested groups. Each user is listed once however many
+// privileged groups it is a member of.
+func listUserTransitiveMemberOf(ctx context.Context, client client.AzureClient, groupMembers <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		params  = query.GraphParams{Select: []string{"id", "displayName"}}
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		seen := make(map[string]struct{})
+		for result := range pipeline.OrDone(ctx.Done(), groupMembers) {
+			if wrapper, ok := result.(AzureWrapper); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to enumerate user transitive memberships for this item", "result", result)
+			} else if members, ok := wrapper.Data.(models.GroupTransitiveMembers); !ok {
+				log.Error(fmt.Errorf("failed group transitive members type assertion"), "unable to enumerate user transitive memberships for this item", "result", result)
+			} else {
+				for _, member := range members.Members {
+					if ref, err := newDirectoryObjectRef(member.Member); err != nil || ref.ODataType != "#microsoft.graph.user" {
+						continue
+					} else if _, ok := seen[ref.Id]; !ok {
+						seen[ref.Id] = struct{}{}
+						if ok := pipeline.Send(ctx.Done(), ids, ref.Id); !ok {
+							return
+						}
+					}
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				memberOf := models.UserTransitiveMemberOf{
+					UserId: id,
+				}
+				for item := range client.ListAzureADUserTransitiveMemberOf(ctx, id, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing transitive memberships for this user", "userId", id)
+					} else {
+						memberOf.MemberOf = append(memberOf.MemberOf, item.Ok)
+					}
+				}
+
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZUserTransitiveMemberOf,
+					Data: memberOf,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing user transitive memberships", "userId", id, "count", len(memberOf.MemberOf))
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all user transitive memberships")
+	}()
+
+	return out
+}
+
+// privilegedGroups drains the sources stream and returns the groups that are role-assignable, that hold a directory or
+// Azure role through any record implementing models.RoleAssignmentHolder, or that own an application or service
+// principal.
+func privilegedGroups(ctx context.Context, sources <-chan interface{}) []string {
+	var (
+		assignable = make(map[string]bool)
+		principals = make(map[string]struct{})
+		addOwners  = func(owners ...json.RawMessage) {
+			for _, owner := range owners {
+				if ref, err := newDirectoryObjectRef(owner); err == nil {
+					principals[ref.Id] = struct{}{}
+				}
+			}
+		}
+	)
+
+	for result := range pipeline.OrDone(ctx.Done(), sources) {
+		var data interface{}
+		if wrapper, ok := result.(AzureWrapper); ok {
+			data = wrapper.Data
+		} else if wrapper, ok := result.(interface{ data() interface{} }); ok {
+			data = wrapper.data()
+		}
+
+		switch data := data.(type) {
+		case models.Group:
+			assignable[data.Id] = data.IsAssignableToRole
+		case models.AppOwners:
+			for _, owner := range data.Owners {
+				addOwners(owner.Owner)
+			}
+		case models.ServicePrincipalOwners:
+			for _, owner := range data.Owners {
+				addOwners(owner.Owner)
+			}
+		case models.RoleAssignmentHolder:
+			for _, id := range data.AssignedPrincipalIds() {
+				principals[id] = struct{}{}
+			}
+		}
+	}
+
+	var groups []string
+	for id, isAssignableToRole := range assignable {
+		if _, ok := principals[id]; ok || isAssignableToRole {
+			groups = append(groups, id)
+		}
+	}
+	slices.Sort(groups)
+	return groups
+}
+
+// membershipDepths resolves how many memberships separate a member from a group. /transitiveMembers flattens the
+// membership without saying how each member was reached, so when the group has nested groups the depths are found by
+// walking the direct members of the group and its nested groups breadth first. Groups without nested groups, which
+// include every dynamic group however large, need no walk since all their transitive members are direct members.
+type membershipDepths struct {
+	// nil when the group has no nested groups
+	depths map[string]int
+}
+
+func newMembershipDepths(ctx context.Context, client client.AzureClient, groupId string) membershipDepths {
+	var (
+		nested = make(map[string]struct{})
+		params = query.GraphParams{Select: []string{"id"}}
+	)
+
+	for item := range client.ListAzureADGroupTransitiveMemberGroups(ctx, groupId, params) {
+		if item.Error != nil {
+			log.Error(item.Error, "unable to list nested groups, membership depths will be unknown", "groupId", groupId)
+			return membershipDepths{depths: map[string]int{}}
+		} else if group, err := newDirectoryObjectRef(item.Ok); err != nil {
+			log.Error(err, "unable to parse nested group", "groupId", groupId)
+		} else {
+			nested[group.Id] = struct{}{}
+		}
+	}
+
+	if len(nested) == 0 {
+		return membershipDepths{}
+	}
+
+	var (
+		depths = map[string]int{groupId: 0}
+		queue  = []string{groupId}
+	)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for item := range client.ListAzureADGroupMembers(ctx, current, params) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to determine membership depth through this group", "groupId", groupId, "nestedGroupId", current)
+			} else if member, err := newDirectoryObjectRef(item.Ok); err != nil {
+				log.Error(err, "unable to parse group member", "groupId", current)
+			} else if _, seen := depths[member.Id]; !seen {
+				depths[member.Id] = depths[current] + 1
+				if _, ok := nested[member.Id]; ok {
+					queue = append(queue, member.Id)
+				}
+			}
+		}
+	}
+
+	return membershipDepths{depths: depths}
+}
+
+// of returns the depth of the member, or nil when the walk did not reach it.
+func (s membershipDepths) of(memberId string) *int {
+	if s.depths == nil {
+		depth := 1
+		return &depth
+	} else if depth, ok := s.depths[memberId]; ok {
+		return &depth
+	} else {
+		return nil
+	}
+}
+
+type directoryObjectRef struct {
+	Id        string `json:"id"`
+	ODataType string `json:"@odata.type"`
+	raw       json.RawMessage
+}
+
+func newDirectoryObjectRef(raw json.RawMessage) (directoryObjectRef, error) {
+	ref := directoryObjectRef{raw: raw}
+	err := json.Unmarshal(raw, &ref)
+	return ref, err
+}
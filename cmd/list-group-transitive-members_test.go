@@ -0,0 +1,304 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListGroupTransitiveMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockSourcesChannel    = make(chan interface{})
+		mockTransitiveChannel = make(chan client.AzureResult[json.RawMessage])
+		mockMembersChannel    = make(chan client.AzureResult[json.RawMessage])
+		mockNestedChannel     = make(chan client.AzureResult[json.RawMessage])
+		mockGroupsChannel     = make(chan client.AzureResult[json.RawMessage])
+		directUser            = json.RawMessage(`{"@odata.type":"#microsoft.graph.user","id":"direct-user"}`)
+		nestedGroup           = json.RawMessage(`{"@odata.type":"#microsoft.graph.group","id":"nested-group"}`)
+		nestedUser            = json.RawMessage(`{"@odata.type":"#microsoft.graph.user","id":"nested-user"}`)
+		unreachedUser         = json.RawMessage(`{"@odata.type":"#microsoft.graph.user","id":"unreached-user"}`)
+	)
+
+	mockClient.EXPECT().ListAzureADGroupTransitiveMembers(gomock.Any(), "role-group", gomock.Any()).Return(mockTransitiveChannel).Times(1)
+	mockClient.EXPECT().ListAzureADGroupTransitiveMemberGroups(gomock.Any(), "role-group", gomock.Any()).Return(mockGroupsChannel).Times(1)
+	mockClient.EXPECT().ListAzureADGroupMembers(gomock.Any(), "role-group", gomock.Any()).Return(mockMembersChannel).Times(1)
+	mockClient.EXPECT().ListAzureADGroupMembers(gomock.Any(), "nested-group", gomock.Any()).Return(mockNestedChannel).Times(1)
+	channel := listGroupTransitiveMembers(ctx, mockClient, mockSourcesChannel)
+
+	go func() {
+		defer close(mockSourcesChannel)
+		mockSourcesChannel <- AzureWrapper{
+			Data: models.Group{Group: azure.Group{DirectoryObject: azure.DirectoryObject{Id: "role-group"}}},
+		}
+		mockSourcesChannel <- AzureWrapper{
+			Data: models.Group{Group: azure.Group{DirectoryObject: azure.DirectoryObject{Id: "unprivileged-group"}}},
+		}
+		mockSourcesChannel <- AzureWrapper{
+			Data: models.RoleAssignments{RoleAssignments: []azure.UnifiedRoleAssignment{{PrincipalId: "role-group"}}},
+		}
+	}()
+	go func() {
+		defer close(mockTransitiveChannel)
+		mockTransitiveChannel <- client.AzureResult[json.RawMessage]{Ok: directUser}
+		mockTransitiveChannel <- client.AzureResult[json.RawMessage]{Ok: nestedGroup}
+		mockTransitiveChannel <- client.AzureResult[json.RawMessage]{Ok: nestedUser}
+		mockTransitiveChannel <- client.AzureResult[json.RawMessage]{Ok: unreachedUser}
+	}()
+	go func() {
+		defer close(mockGroupsChannel)
+		mockGroupsChannel <- client.AzureResult[json.RawMessage]{Ok: nestedGroup}
+	}()
+	go func() {
+		defer close(mockMembersChannel)
+		mockMembersChannel <- client.AzureResult[json.RawMessage]{Ok: directUser}
+		mockMembersChannel <- client.AzureResult[json.RawMessage]{Ok: nestedGroup}
+	}()
+	go func() {
+		defer close(mockNestedChannel)
+		mockNestedChannel <- client.AzureResult[json.RawMessage]{Ok: nestedUser}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.GroupTransitiveMembers); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.GroupTransitiveMembers{})
+	} else if data.GroupId != "role-group" || len(data.Members) != 4 {
+		t.Errorf("got %v, want 4 transitive members of role-group", data)
+	} else {
+		for i, want := range []int{1, 1, 2} {
+			if depth := data.Members[i].Depth; depth == nil || *depth != want {
+				t.Errorf("got depth %v for member %d, want %d", depth, i, want)
+			}
+		}
+		if depth := data.Members[3].Depth; depth != nil {
+			t.Errorf("got depth %d for a member not reached through direct memberships, want nil", *depth)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
+
+func TestListGroupTransitiveMembers_Chunked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockSourcesChannel    = make(chan interface{})
+		mockTransitiveChannel = make(chan client.AzureResult[json.RawMessage])
+		mockGroupsChannel     = make(chan client.AzureResult[json.RawMessage])
+	)
+
+	mockClient.EXPECT().ListAzureADGroupTransitiveMemberGroups(gomock.Any(), "role-group", gomock.Any()).Return(mockGroupsChannel).Times(1)
+	mockClient.EXPECT().ListAzureADGroupTransitiveMembers(gomock.Any(), "role-group", gomock.Any()).Return(mockTransitiveChannel).Times(1)
+	channel := listGroupTransitiveMembers(ctx, mockClient, mockSourcesChannel)
+
+	go func() {
+		defer close(mockSourcesChannel)
+		mockSourcesChannel <- AzureWrapper{
+			Data: models.Group{Group: azure.Group{DirectoryObject: azure.DirectoryObject{Id: "role-group"}, IsAssignableToRole: true}},
+		}
+	}()
+	go func() {
+		defer close(mockGroupsChannel)
+	}()
+
+	// the first chunk must arrive while the listing is still in progress
+	done := make(chan struct{})
+	go func() {
+		defer close(mockTransitiveChannel)
+		for i := 0; i < recordChunkSize+1; i++ {
+			if i == recordChunkSize {
+				<-done
+			}
+			mockTransitiveChannel <- client.AzureResult[json.RawMessage]{
+				Ok: json.RawMessage(`{"@odata.type":"#microsoft.graph.user","id":"user"}`),
+			}
+		}
+	}()
+
+	for _, want := range []int{recordChunkSize, 1} {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if data, ok := result.(AzureWrapper).Data.(models.GroupTransitiveMembers); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.GroupTransitiveMembers{})
+		} else if len(data.Members) != want {
+			t.Errorf("got %v, want %v", len(data.Members), want)
+		} else if depth := data.Members[0].Depth; depth == nil || *depth != 1 {
+			t.Errorf("got depth %v, want 1", depth)
+		}
+		if want == recordChunkSize {
+			close(done)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
+
+func TestPrivilegedGroups_AzureRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+
+	var (
+		groups                  = make(chan interface{})
+		others                  = make(chan interface{})
+		subscriptionAssignments = make(chan interface{})
+		keyVaultAssignments     = make(chan azureWrapper[models.KeyVaultRoleAssignments])
+		assignment              = func(principalId, roleId string) azure.RoleAssignment {
+			return azure.RoleAssignment{Properties: azure.RoleAssignmentPropertiesWithScope{
+				PrincipalId:      principalId,
+				RoleDefinitionId: "/providers/Microsoft.Authorization/roleDefinitions/" + roleId,
+			}}
+		}
+	)
+
+	// the role assignments reach the output only through the records derived by these collectors
+	sources := pipeline.Mux(ctx.Done(),
+		groups,
+		others,
+		listSubscriptionOwners(ctx, mockClient, subscriptionAssignments, nil),
+		listKeyVaultContributors(ctx, keyVaultAssignments, nil),
+	)
+
+	go func() {
+		defer close(groups)
+		for _, id := range []string{"subscription-owners", "key-vault-contributors", "eligible-owners", "readers", "attached"} {
+			groups <- AzureWrapper{Data: models.Group{Group: azure.Group{DirectoryObject: azure.DirectoryObject{Id: id}}}}
+		}
+	}()
+	go func() {
+		defer close(others)
+		others <- AzureWrapper{Data: models.ResourceRoleEligibilityScheduleInstance{
+			RoleEligibilityScheduleInstance: azure.RoleEligibilityScheduleInstance{
+				Properties: azure.RoleEligibilityScheduleInstanceProperties{PrincipalId: "eligible-owners"},
+			},
+		}}
+		// records that merely reference principals do not make them privileged
+		others <- AzureWrapper{Data: models.UserAssignedIdentityAttachments{
+			Identities: []models.UserAssignedIdentityAttachment{{PrincipalId: "attached"}},
+		}}
+	}()
+	go func() {
+		defer close(subscriptionAssignments)
+		subscriptionAssignments <- AzureWrapper{Data: models.SubscriptionRoleAssignments{
+			RoleAssignments: []models.SubscriptionRoleAssignment{{RoleAssignment: assignment("subscription-owners", constants.OwnerRoleID)}},
+		}}
+	}()
+	go func() {
+		defer close(keyVaultAssignments)
+		keyVaultAssignments <- NewAzureWrapper(enums.KindAZKeyVaultRoleAssignment, models.KeyVaultRoleAssignments{
+			RoleAssignments: []models.KeyVaultRoleAssignment{
+				{RoleAssignment: assignment("key-vault-contributors", constants.ContributorRoleID)},
+				{RoleAssignment: assignment("readers", constants.ReaderRoleID)},
+			},
+		})
+	}()
+
+	want := []string{"eligible-owners", "key-vault-contributors", "subscription-owners"}
+	if groups := privilegedGroups(ctx, sources); !slices.Equal(groups, want) {
+		t.Errorf("got %v, want %v", groups, want)
+	}
+}
+
+func TestListUserTransitiveMemberOf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockGroupMembersChannel = make(chan interface{})
+		mockMemberOfChannel     = make(chan client.AzureResult[json.RawMessage])
+		user                    = json.RawMessage(`{"@odata.type":"#microsoft.graph.user","id":"user"}`)
+		nestedGroup             = json.RawMessage(`{"@odata.type":"#microsoft.graph.group","id":"nested-group"}`)
+		directoryRole           = json.RawMessage(`{"@odata.type":"#microsoft.graph.directoryRole","id":"role"}`)
+	)
+
+	// the user is listed once even though it is a member of two privileged groups
+	mockClient.EXPECT().ListAzureADUserTransitiveMemberOf(gomock.Any(), "user", gomock.Any()).Return(mockMemberOfChannel).Times(1)
+	channel := listUserTransitiveMemberOf(ctx, mockClient, mockGroupMembersChannel)
+
+	go func() {
+		defer close(mockGroupMembersChannel)
+		mockGroupMembersChannel <- AzureWrapper{Data: models.GroupTransitiveMembers{
+			GroupId: "role-group",
+			Members: []models.GroupTransitiveMember{{Member: user}, {Member: nestedGroup}},
+		}}
+		mockGroupMembersChannel <- AzureWrapper{Data: models.Group{}}
+		mockGroupMembersChannel <- AzureWrapper{Data: models.GroupTransitiveMembers{
+			GroupId: "owner-group",
+			Members: []models.GroupTransitiveMember{{Member: user}},
+		}}
+	}()
+	go func() {
+		defer close(mockMemberOfChannel)
+		mockMemberOfChannel <- client.AzureResult[json.RawMessage]{Ok: nestedGroup}
+		mockMemberOfChannel <- client.AzureResult[json.RawMessage]{Ok: directoryRole}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if wrapper.Kind != enums.KindAZUserTransitiveMemberOf {
+		t.Errorf("got kind %v, want %v", wrapper.Kind, enums.KindAZUserTransitiveMemberOf)
+	} else if data, ok := wrapper.Data.(models.UserTransitiveMemberOf); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.UserTransitiveMemberOf{})
+	} else if data.UserId != "user" || len(data.MemberOf) != 2 {
+		t.Errorf("got %v, want 2 transitive memberships of user", data)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listIntuneManagedDevicesCmd)
+}
+
+var listIntuneManagedDevicesCmd = &cobra.Command{
+	Use:          "intune-managed-devices",
+	Long:         "Lists Intune Managed Devices",
+	Run:          listIntuneManagedDevicesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listIntuneManagedDevicesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting intune managed devices...")
+	start := time.Now()
+	stream := listIntuneManagedDevices(ctx, azClient, listDevices(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listIntuneManagedDevices reads the whole devices stream first so that each managed device can be matched to the
+// object id of its Microsoft Entra device, which Intune only references by device id.
+func listIntuneManagedDevices(ctx context.Context, client client.AzureClient, devices <-chan interface{}) <-chan interface{} {
+	var (
+		out    = make(chan interface{})
+		params = query.GraphParams{Select: []string{
+			"id",
+			"deviceName",
+			"azureADDeviceId",
+			"complianceState",
+			"operatingSystem",
+			"osVersion",
+			"managementAgent",
+			"managedDeviceOwnerType",
+			"deviceEnrollmentType",
+			"userId",
+			"userPrincipalName",
+			"isEncrypted",
+			"jailBroken",
+			"enrolledDateTime",
+			"lastSyncDateTime",
+			"roleScopeTagIds",
+		}}
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		objectIds := make(map[string]string)
+		for result := range pipeline.OrDone(ctx.Done(), devices) {
+			if device, ok := result.(AzureWrapper).Data.(models.Device); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to map this device to its intune managed device", "result", result)
+				continue
+			} else if device.DeviceId != "" {
+				objectIds[device.DeviceId] = device.Id
+			}
+		}
+
+		count := 0
+		for item := range client.ListAzureIntuneManagedDevices(ctx, params) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing intune managed devices")
+				return
+			} else {
+				log.V(2).Info("found intune managed device", "managedDevice", item)
+				count++
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZIntuneManagedDevice,
+					Data: models.IntuneManagedDevice{
+						IntuneManagedDevice: item.Ok,
+						DeviceObjectId:      objectIds[item.Ok.AzureADDeviceId],
+						TenantId:            client.TenantInfo().TenantId,
+						TenantName:          client.TenantInfo().DisplayName,
+					},
+				}); !ok {
+					return
+				}
+			}
+		}
+		log.Info("finished listing all intune managed devices", "count", count)
+	}()
+
+	return out
+}
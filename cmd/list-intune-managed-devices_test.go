@@ -0,0 +1,88 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListIntuneManagedDevices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockDevicesChannel = make(chan interface{})
+		mockChannel        = make(chan client.AzureResult[azure.IntuneManagedDevice])
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureIntuneManagedDevices(gomock.Any(), gomock.Any()).Return(mockChannel).Times(1)
+	channel := listIntuneManagedDevices(ctx, mockClient, mockDevicesChannel)
+
+	go func() {
+		defer close(mockDevicesChannel)
+		// unexpected items are skipped without abandoning the stream
+		mockDevicesChannel <- AzureWrapper{Data: models.User{}}
+		mockDevicesChannel <- AzureWrapper{
+			Data: models.Device{Device: azure.Device{DirectoryObject: azure.DirectoryObject{Id: "device-object"}, DeviceId: "device-id"}},
+		}
+	}()
+	go func() {
+		defer close(mockChannel)
+		mockChannel <- client.AzureResult[azure.IntuneManagedDevice]{
+			Ok: azure.IntuneManagedDevice{AzureADDeviceId: "device-id", ComplianceState: "compliant", UserId: "primary-user"},
+		}
+		mockChannel <- client.AzureResult[azure.IntuneManagedDevice]{
+			Ok: azure.IntuneManagedDevice{AzureADDeviceId: "unregistered-device-id"},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.IntuneManagedDevice); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.IntuneManagedDevice{})
+	} else if data.DeviceObjectId != "device-object" || data.ComplianceState != "compliant" || data.UserId != "primary-user" {
+		t.Errorf("got %v, want a compliant device joined to device-object", data)
+	}
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.IntuneManagedDevice); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.IntuneManagedDevice{})
+	} else if data.DeviceObjectId != "" {
+		t.Errorf("got %v, want no device object id", data.DeviceObjectId)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
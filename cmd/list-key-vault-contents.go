@@ -0,0 +1,168 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listKeyVaultContentsCmd)
+}
+
+var listKeyVaultContentsCmd = &cobra.Command{
+	Use:          "key-vault-contents",
+	Long:         "Lists the names and metadata of Azure Key Vault Secrets, Keys and Certificates",
+	Run:          listKeyVaultContentsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listKeyVaultContentsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure key vault contents...")
+	start := time.Now()
+	stream := listKeyVaultContents(ctx, azClient, listKeyVaults(ctx, azClient, listSubscriptions(ctx, azClient)))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listKeyVaultContents lists secret, key and certificate metadata from each vault's data plane. Vaults the caller
+// cannot list are logged and reported with whatever collections could be read.
+func listKeyVaultContents(ctx context.Context, client client.AzureClient, keyVaults <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		vaults  = make(chan models.KeyVault)
+		streams = pipeline.Demux(ctx.Done(), vaults, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	if config.JWT.Value().(string) != "" {
+		log.Info("warning: skipping key vault contents, a supplied JWT cannot be used to authenticate to key vault data planes")
+		return pipeline.Filter(ctx.Done(), keyVaults, func(interface{}) bool { return false })
+	}
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(vaults)
+
+		for result := range pipeline.OrDone(ctx.Done(), keyVaults) {
+			if keyVault, ok := result.(AzureWrapper).Data.(models.KeyVault); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to enumerate key vault contents for this item", "result", result)
+				continue
+			} else if keyVault.Properties.VaultUri == "" {
+				log.V(1).Info("skipping key vault without a vault uri", "keyVaultId", keyVault.Id)
+			} else {
+				if ok := pipeline.Send(ctx.Done(), vaults, keyVault); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for keyVault := range stream {
+				var (
+					uri      = keyVault.Properties.VaultUri
+					contents = models.KeyVaultContents{
+						KeyVaultId: keyVault.Id,
+						TenantId:   keyVault.TenantId,
+					}
+				)
+
+				for item := range client.ListAzureKeyVaultSecrets(ctx, uri, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing secrets for this key vault", "keyVaultId", keyVault.Id)
+					} else {
+						contents.Secrets = append(contents.Secrets, newKeyVaultItem(item.Ok.Name(), item.Ok.Attributes, item.Ok.Tags, item.Ok.ContentType, item.Ok.Managed))
+					}
+				}
+
+				for item := range client.ListAzureKeyVaultKeys(ctx, uri, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing keys for this key vault", "keyVaultId", keyVault.Id)
+					} else {
+						contents.Keys = append(contents.Keys, newKeyVaultItem(item.Ok.Name(), item.Ok.Attributes, item.Ok.Tags, "", item.Ok.Managed))
+					}
+				}
+
+				for item := range client.ListAzureKeyVaultCertificates(ctx, uri, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing certificates for this key vault", "keyVaultId", keyVault.Id)
+					} else {
+						contents.Certificates = append(contents.Certificates, newKeyVaultItem(item.Ok.Name(), item.Ok.Attributes, item.Ok.Tags, "", false))
+					}
+				}
+
+				log.V(2).Info("found key vault contents", "contents", contents)
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZKeyVaultContents,
+					Data: contents,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing key vault contents", "keyVaultId", keyVault.Id, "secrets", len(contents.Secrets), "keys", len(contents.Keys), "certificates", len(contents.Certificates))
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all key vault contents")
+	}()
+
+	return out
+}
+
+func newKeyVaultItem(name string, attributes azure.KeyVaultItemAttributes, tags map[string]string, contentType string, managed bool) models.KeyVaultItem {
+	return models.KeyVaultItem{
+		Name:        name,
+		ContentType: contentType,
+		Enabled:     attributes.Enabled,
+		Expires:     attributes.Expires,
+		Managed:     managed,
+		NotBefore:   attributes.NotBefore,
+		Tags:        tags,
+	}
+}
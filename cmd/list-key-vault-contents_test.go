@@ -0,0 +1,142 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListKeyVaultContents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		vaultId                 = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.KeyVault/vaults/baz"
+		vaultUri                = "https://baz.vault.azure.net/"
+		mockKeyVaultsChannel    = make(chan interface{})
+		mockSecretsChannel      = make(chan client.AzureResult[azure.KeyVaultSecretItem])
+		mockKeysChannel         = make(chan client.AzureResult[azure.KeyVaultKeyItem])
+		mockCertificatesChannel = make(chan client.AzureResult[azure.KeyVaultCertificateItem])
+	)
+
+	mockClient.EXPECT().ListAzureKeyVaultSecrets(gomock.Any(), vaultUri, query.RMParams{}).Return(mockSecretsChannel).Times(1)
+	mockClient.EXPECT().ListAzureKeyVaultKeys(gomock.Any(), vaultUri, query.RMParams{}).Return(mockKeysChannel).Times(1)
+	mockClient.EXPECT().ListAzureKeyVaultCertificates(gomock.Any(), vaultUri, query.RMParams{}).Return(mockCertificatesChannel).Times(1)
+	channel := listKeyVaultContents(ctx, mockClient, mockKeyVaultsChannel)
+
+	go func() {
+		defer close(mockKeyVaultsChannel)
+		mockKeyVaultsChannel <- AzureWrapper{
+			Kind: enums.KindAZKeyVault,
+			Data: models.KeyVault{
+				KeyVault: azure.KeyVault{
+					Entity:     azure.Entity{Id: vaultId},
+					Properties: azure.VaultProperties{VaultUri: vaultUri},
+				},
+			},
+		}
+		// a vault without a uri cannot be reached and is skipped
+		mockKeyVaultsChannel <- AzureWrapper{
+			Kind: enums.KindAZKeyVault,
+			Data: models.KeyVault{},
+		}
+	}()
+	go func() {
+		defer close(mockSecretsChannel)
+		mockSecretsChannel <- client.AzureResult[azure.KeyVaultSecretItem]{
+			Ok: azure.KeyVaultSecretItem{
+				Id:          vaultUri + "secrets/sql-password",
+				ContentType: "text/plain",
+				Attributes:  azure.KeyVaultItemAttributes{Enabled: true, Expires: 1767225600},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockKeysChannel)
+		mockKeysChannel <- client.AzureResult[azure.KeyVaultKeyItem]{
+			Error: fmt.Errorf("I'm an error"),
+		}
+	}()
+	go func() {
+		defer close(mockCertificatesChannel)
+		mockCertificatesChannel <- client.AzureResult[azure.KeyVaultCertificateItem]{
+			Ok: azure.KeyVaultCertificateItem{Id: vaultUri + "certificates/web"},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.KeyVaultContents); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.KeyVaultContents{})
+	} else if data.KeyVaultId != vaultId || len(data.Secrets) != 1 || len(data.Keys) != 0 || len(data.Certificates) != 1 {
+		t.Errorf("got %v, want 1 secret, 0 keys and 1 certificate for %s", data, vaultId)
+	} else if secret := data.Secrets[0]; secret.Name != "sql-password" || !secret.Enabled || secret.Expires != 1767225600 || secret.ContentType != "text/plain" {
+		t.Errorf("got %v, want enabled secret sql-password with its expiry and content type", secret)
+	} else if data.Certificates[0].Name != "web" {
+		t.Errorf("got %v, want certificate web", data.Certificates[0])
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
+
+func TestListKeyVaultContents_JWT(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	// a supplied token is not valid for the vault data planes, so no requests are made
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockKeyVaultsChannel := make(chan interface{})
+
+	config.JWT.Set("token")
+	defer config.JWT.Set("")
+	channel := listKeyVaultContents(ctx, mockClient, mockKeyVaultsChannel)
+
+	go func() {
+		defer close(mockKeyVaultsChannel)
+		mockKeyVaultsChannel <- AzureWrapper{
+			Data: models.KeyVault{KeyVault: azure.KeyVault{Properties: azure.VaultProperties{VaultUri: "https://foo.vault.azure.net/"}}},
+		}
+	}()
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -43,7 +43,7 @@ func TestListKeyVaultKVContributors(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan azureWrapper[models.KeyVaultRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listKeyVaultKVContributors(ctx, mockRoleAssignmentsChannel)
+	channel := listKeyVaultKVContributors(ctx, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
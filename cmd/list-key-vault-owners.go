@@ -51,11 +51,12 @@ func listKeyVaultOwnersCmdImpl(cmd *cobra.Command, args []string) {
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure key vault owners...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	subscriptions := listSubscriptions(ctx, azClient)
 	keyVaults := listKeyVaults(ctx, azClient, subscriptions)
 	kvRoleAssignments := listKeyVaultRoleAssignments(ctx, azClient, keyVaults)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
-	stream := listKeyVaultOwners(ctx, kvRoleAssignments)
+	stream := listKeyVaultOwners(ctx, kvRoleAssignments, roleDefinitions)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
@@ -64,9 +65,10 @@ func listKeyVaultOwnersCmdImpl(cmd *cobra.Command, args []string) {
 func listKeyVaultOwners(
 	ctx context.Context,
 	kvRoleAssignments <-chan azureWrapper[models.KeyVaultRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
 ) <-chan any {
 	return pipeline.Map(ctx.Done(), kvRoleAssignments, func(ra azureWrapper[models.KeyVaultRoleAssignments]) any {
-		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, kvRoleAssignmentFilter(constants.OwnerRoleID))
+		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, kvRoleAssignmentFilter(roleDefinitions, constants.OwnerRoleID))
 
 		kvContributors := internal.Map(filteredAssignments, func(ra models.KeyVaultRoleAssignment) models.KeyVaultOwner {
 			return models.KeyVaultOwner{
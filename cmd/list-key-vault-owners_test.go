@@ -43,7 +43,7 @@ func TestListKeyVaultOwners(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan azureWrapper[models.KeyVaultRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listKeyVaultOwners(ctx, mockRoleAssignmentsChannel)
+	channel := listKeyVaultOwners(ctx, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
@@ -51,11 +51,12 @@ func listKeyVaultUserAccessAdminsCmdImpl(cmd *cobra.Command, args []string) {
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure key vault user access admins...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	subscriptions := listSubscriptions(ctx, azClient)
 	keyVaults := listKeyVaults(ctx, azClient, subscriptions)
 	kvRoleAssignments := listKeyVaultRoleAssignments(ctx, azClient, keyVaults)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
-	stream := listKeyVaultUserAccessAdmins(ctx, kvRoleAssignments)
+	stream := listKeyVaultUserAccessAdmins(ctx, kvRoleAssignments, roleDefinitions)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
@@ -64,9 +65,10 @@ func listKeyVaultUserAccessAdminsCmdImpl(cmd *cobra.Command, args []string) {
 func listKeyVaultUserAccessAdmins(
 	ctx context.Context,
 	kvRoleAssignments <-chan azureWrapper[models.KeyVaultRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
 ) <-chan any {
 	return pipeline.Map(ctx.Done(), kvRoleAssignments, func(ra azureWrapper[models.KeyVaultRoleAssignments]) any {
-		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, kvRoleAssignmentFilter(constants.UserAccessAdminRoleID))
+		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, kvRoleAssignmentFilter(roleDefinitions, constants.UserAccessAdminRoleID))
 
 		kvContributors := internal.Map(filteredAssignments, func(ra models.KeyVaultRoleAssignment) models.KeyVaultUserAccessAdmin {
 			return models.KeyVaultUserAccessAdmin{
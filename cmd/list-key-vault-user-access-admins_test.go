@@ -43,7 +43,7 @@ func TestListKeyVaultUserAccessAdmins(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan azureWrapper[models.KeyVaultRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listKeyVaultUserAccessAdmins(ctx, mockRoleAssignmentsChannel)
+	channel := listKeyVaultUserAccessAdmins(ctx, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
@@ -0,0 +1,164 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listLighthouseDelegationsCmd)
+}
+
+var listLighthouseDelegationsCmd = &cobra.Command{
+	Use:          "lighthouse-delegations",
+	Long:         "Lists Azure Lighthouse Delegations of Subscriptions and Resource Groups to Managing Tenants",
+	Run:          listLighthouseDelegationsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listLighthouseDelegationsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure lighthouse delegations...")
+	start := time.Now()
+	stream := listLighthouseDelegations(ctx, azClient, listSubscriptions(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listLighthouseDelegations lists the registration assignments made at each subscription and at the resource groups
+// within it. A single listing per subscription covers both, since including all scopes returns the assignments of its
+// resource groups as well.
+func listLighthouseDelegations(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), listRBACScopes(ctx, subscriptions), config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		params  = query.RMParams{ExpandRegistrationDef: true, Filter: "includeAllScopes"}
+	)
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for scope := range stream {
+				count := 0
+				for item := range client.ListAzureRegistrationAssignments(ctx, scope, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing lighthouse delegations for this subscription", "scope", scope)
+					} else {
+						delegation := formatLighthouseDelegation(item.Ok, assignmentScope(item.Ok.Id, scope), client.TenantInfo().TenantId)
+
+						log.V(2).Info("found lighthouse delegation", "lighthouseDelegation", delegation)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZLighthouseDelegation,
+							Data: delegation,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing lighthouse delegations", "scope", scope, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all lighthouse delegations")
+	}()
+
+	return out
+}
+
+// assignmentScope returns the scope the registration assignment identified by id was made at, which is the subscription
+// itself or one of its resource groups
+func assignmentScope(id, subscription string) string {
+	const suffix = "/providers/microsoft.managedservices/registrationassignments/"
+	if idx := strings.LastIndex(strings.ToLower(id), suffix); idx > 0 {
+		return id[:idx]
+	} else {
+		return subscription
+	}
+}
+
+func formatLighthouseDelegation(assignment azure.RegistrationAssignment, scope, tenantId string) models.LighthouseDelegation {
+	var (
+		definition = assignment.Properties.RegistrationDefinition.Properties
+		foreign    = !strings.EqualFold(definition.ManagedByTenantId, tenantId)
+		delegation = models.LighthouseDelegation{
+			AssignmentId:               assignment.Id,
+			Scope:                      scope,
+			RegistrationDefinitionId:   assignment.Properties.RegistrationDefinitionId,
+			RegistrationDefinitionName: definition.RegistrationDefinitionName,
+			ManagedByTenantId:          definition.ManagedByTenantId,
+			ManagedByTenantName:        definition.ManagedByTenantName,
+			TenantId:                   tenantId,
+		}
+	)
+
+	for _, authorization := range definition.Authorizations {
+		delegation.Principals = append(delegation.Principals, models.LighthouseDelegatedPrincipal{
+			PrincipalId:                authorization.PrincipalId,
+			PrincipalDisplayName:       authorization.PrincipalIdDisplayName,
+			PrincipalTenantId:          definition.ManagedByTenantId,
+			Foreign:                    foreign,
+			RoleDefinitionId:           path.Base(authorization.RoleDefinitionId),
+			DelegatedRoleDefinitionIds: authorization.DelegatedRoleDefinitionIds,
+		})
+	}
+
+	for _, authorization := range definition.EligibleAuthorizations {
+		delegation.Principals = append(delegation.Principals, models.LighthouseDelegatedPrincipal{
+			PrincipalId:          authorization.PrincipalId,
+			PrincipalDisplayName: authorization.PrincipalIdDisplayName,
+			PrincipalTenantId:    definition.ManagedByTenantId,
+			Foreign:              foreign,
+			RoleDefinitionId:     path.Base(authorization.RoleDefinitionId),
+			Eligible:             true,
+		})
+	}
+
+	return delegation
+}
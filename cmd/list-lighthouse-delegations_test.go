@@ -0,0 +1,111 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListLighthouseDelegations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		subscriptionId           = "/subscriptions/foo"
+		resourceGroupId          = "/subscriptions/foo/resourceGroups/bar"
+		mockSubscriptionsChannel = make(chan interface{})
+		mockSubscriptionChannel  = make(chan client.AzureResult[azure.RegistrationAssignment])
+		assignment               = azure.RegistrationAssignment{
+			Entity: azure.Entity{Id: resourceGroupId + "/providers/Microsoft.ManagedServices/registrationAssignments/baz"},
+			Properties: azure.RegistrationAssignmentProperties{
+				RegistrationDefinition: azure.RegistrationDefinition{
+					Properties: azure.RegistrationDefinitionProperties{
+						ManagedByTenantId: "msp-tenant",
+						Authorizations: []azure.LighthouseAuthorization{{
+							PrincipalId:      "msp-admins",
+							RoleDefinitionId: constants.ContributorRoleID,
+						}},
+						EligibleAuthorizations: []azure.LighthouseEligibleAuthorization{{
+							PrincipalId:      "msp-escalation",
+							RoleDefinitionId: constants.OwnerRoleID,
+						}},
+					},
+				},
+			},
+		}
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{TenantId: "our-tenant"}).AnyTimes()
+	mockClient.EXPECT().ListAzureRegistrationAssignments(gomock.Any(), subscriptionId, query.RMParams{ExpandRegistrationDef: true, Filter: "includeAllScopes"}).Return(mockSubscriptionChannel).Times(1)
+	channel := listLighthouseDelegations(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{Subscription: azure.Subscription{Entity: azure.Entity{Id: subscriptionId}}},
+		}
+	}()
+	go func() {
+		// the subscription's listing includes the assignments made at its resource groups
+		defer close(mockSubscriptionChannel)
+		mockSubscriptionChannel <- client.AzureResult[azure.RegistrationAssignment]{Ok: assignment}
+		subscriptionAssignment := assignment
+		subscriptionAssignment.Id = subscriptionId + "/providers/Microsoft.ManagedServices/registrationAssignments/qux"
+		mockSubscriptionChannel <- client.AzureResult[azure.RegistrationAssignment]{Ok: subscriptionAssignment}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.LighthouseDelegation); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.LighthouseDelegation{})
+	} else if data.Scope != resourceGroupId || len(data.Principals) != 2 {
+		t.Errorf("got %v, want 2 principals delegated at %s", data, resourceGroupId)
+	} else if principal := data.Principals[1]; !principal.Foreign || !principal.Eligible || principal.RoleDefinitionId != constants.OwnerRoleID || principal.PrincipalTenantId != "msp-tenant" {
+		t.Errorf("got %v, want a foreign eligible owner from msp-tenant", principal)
+	}
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.LighthouseDelegation); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.LighthouseDelegation{})
+	} else if data.Scope != subscriptionId {
+		t.Errorf("got delegation at %s, want %s", data.Scope, subscriptionId)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listLogicAppApiConnectionsCmd)
+}
+
+var listLogicAppApiConnectionsCmd = &cobra.Command{
+	Use:          "logic-app-api-connections",
+	Long:         "Lists the API Connections referenced by Azure Logic Apps",
+	Run:          listLogicAppApiConnectionsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listLogicAppApiConnectionsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure logic app api connections...")
+	start := time.Now()
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listLogicAppApiConnections(ctx, listLogicApps(ctx, azClient, subscriptions))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listLogicAppApiConnections relates each Logic App to the API connections referenced by its workflow. Logic Apps that
+// reference none are skipped.
+func listLogicAppApiConnections(ctx context.Context, logicApps <-chan interface{}) <-chan interface{} {
+	out := make(chan interface{})
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		for result := range pipeline.OrDone(ctx.Done(), logicApps) {
+			if logicApp, ok := result.(AzureWrapper).Data.(models.LogicApp); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating logic app api connections", "result", result)
+				return
+			} else if connections := logicApp.ApiConnections(); len(connections) > 0 {
+				logicAppApiConnections := models.LogicAppApiConnections{
+					LogicAppId:  logicApp.Id,
+					Connections: connections,
+					TenantId:    logicApp.TenantId,
+				}
+				log.V(2).Info("found logic app api connections", "logicAppApiConnections", logicAppApiConnections)
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZLogicAppApiConnection,
+					Data: logicAppApiConnections,
+				}); !ok {
+					return
+				}
+			}
+		}
+		log.Info("finished listing all logic app api connections")
+	}()
+
+	return out
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListLogicAppApiConnections(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		connectionId         = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Web/connections/office365"
+		mockLogicAppsChannel = make(chan interface{})
+		connectionsParameter = map[string]interface{}{
+			"office365": map[string]interface{}{
+				"connectionId":   connectionId,
+				"connectionName": "office365",
+				"id":             "/subscriptions/foo/providers/Microsoft.Web/locations/westus/managedApis/office365",
+			},
+		}
+	)
+
+	channel := listLogicAppApiConnections(ctx, mockLogicAppsChannel)
+
+	go func() {
+		defer close(mockLogicAppsChannel)
+
+		// A workflow referencing a connection through its parameters
+		mockLogicAppsChannel <- AzureWrapper{
+			Kind: enums.KindAZLogicApp,
+			Data: models.LogicApp{
+				LogicApp: azure.LogicApp{
+					Entity: azure.Entity{Id: "parameters"},
+					Properties: azure.LogicAppProperties{
+						Parameters: map[string]azure.LogicAppParameter{
+							"$connections": {Type: enums.ObjectType, Value: connectionsParameter},
+						},
+					},
+				},
+			},
+		}
+
+		// A workflow referencing a connection through its definition's defaults
+		mockLogicAppsChannel <- AzureWrapper{
+			Kind: enums.KindAZLogicApp,
+			Data: models.LogicApp{
+				LogicApp: azure.LogicApp{
+					Entity: azure.Entity{Id: "definition"},
+					Properties: azure.LogicAppProperties{
+						Definition: azure.Definition{
+							Parameters: map[string]azure.Parameter{
+								"$connections": {Type: "Object", DefaultValue: connectionsParameter},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		// A workflow without connections
+		mockLogicAppsChannel <- AzureWrapper{
+			Kind: enums.KindAZLogicApp,
+			Data: models.LogicApp{LogicApp: azure.LogicApp{Entity: azure.Entity{Id: "none"}}},
+		}
+	}()
+
+	for _, want := range []string{"parameters", "definition"} {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if data, ok := wrapper.Data.(models.LogicAppApiConnections); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.LogicAppApiConnections{})
+		} else if data.LogicAppId != want || len(data.Connections) != 1 || data.Connections[0].ConnectionId != connectionId {
+			t.Errorf("got %v, want %s to reference %s", data, want, connectionId)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
@@ -23,11 +23,13 @@ import (
 	"os"
 	"os/signal"
 	"path"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
 	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/constants"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
@@ -58,8 +60,9 @@ func listManagedClusterRoleAssignmentImpl(cmd *cobra.Command, args []string) {
 	} else {
 		log.Info("collecting azure managed cluster role assignments...")
 		start := time.Now()
+		roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 		subscriptions := listSubscriptions(ctx, azClient)
-		stream := listManagedClusterRoleAssignments(ctx, azClient, listManagedClusters(ctx, azClient, subscriptions))
+		stream := listManagedClusterRoleAssignments(ctx, azClient, listManagedClusters(ctx, azClient, subscriptions), roleDefinitions)
 		panicrecovery.HandleBubbledPanic(ctx, stop, log)
 		outputStream(ctx, stream)
 		duration := time.Since(start)
@@ -67,7 +70,16 @@ func listManagedClusterRoleAssignmentImpl(cmd *cobra.Command, args []string) {
 	}
 }
 
-func listManagedClusterRoleAssignments(ctx context.Context, client client.AzureClient, managedClusters <-chan interface{}) <-chan interface{} {
+// managedClusterAdminRoleIDs are the built-in roles that grant cluster-admin on a managed cluster, either through its
+// admin kubeconfig or through Azure RBAC for Kubernetes
+var managedClusterAdminRoleIDs = []string{
+	constants.AzureKubernetesServiceClusterAdminRoleID,
+	constants.AzureKubernetesServiceRBACClusterAdminRoleID,
+}
+
+// listManagedClusterRoleAssignments lists the role assignments of each managed cluster along with the assignments
+// that make their principals cluster admins. It also relates each cluster to the identities it authenticates as.
+func listManagedClusterRoleAssignments(ctx context.Context, client client.AzureClient, managedClusters <-chan interface{}, roleDefinitions *roleDefinitionIndex) <-chan interface{} {
 	var (
 		out     = make(chan interface{})
 		ids     = make(chan string)
@@ -75,15 +87,25 @@ func listManagedClusterRoleAssignments(ctx context.Context, client client.AzureC
 		wg      sync.WaitGroup
 	)
 
+	wg.Add(1)
 	go func() {
 		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
 		defer close(ids)
 
 		for result := range pipeline.OrDone(ctx.Done(), managedClusters) {
 			if managedCluster, ok := result.(AzureWrapper).Data.(models.ManagedCluster); !ok {
-				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating managed cluster role assignments", "result", result)
-				return
+				log.Error(fmt.Errorf("failed type assertion"), "unable to enumerate managed cluster role assignments for this item", "result", result)
+				continue
 			} else {
+				for _, identity := range managedClusterIdentities(managedCluster) {
+					if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZManagedClusterIdentity,
+						Data: identity,
+					}); !ok {
+						return
+					}
+				}
 				if ok := pipeline.Send(ctx.Done(), ids, managedCluster.Id); !ok {
 					return
 				}
@@ -102,6 +124,9 @@ func listManagedClusterRoleAssignments(ctx context.Context, client client.AzureC
 					managedClusterRoleAssignments = models.AzureRoleAssignments{
 						ObjectId: id,
 					}
+					managedClusterAdmins = models.ManagedClusterAdmins{
+						ManagedClusterId: id,
+					}
 					count = 0
 				)
 				for item := range client.ListRoleAssignmentsForResource(ctx, id, "", "") {
@@ -118,6 +143,17 @@ func listManagedClusterRoleAssignments(ctx context.Context, client client.AzureC
 						log.V(2).Info("found managed cluster role assignment", "managedClusterRoleAssignment", managedClusterRoleAssignment)
 						count++
 						managedClusterRoleAssignments.RoleAssignments = append(managedClusterRoleAssignments.RoleAssignments, managedClusterRoleAssignment)
+
+						for _, roleId := range managedClusterAdminRoleIDs {
+							if roleDefinitions.grants(item.Ok.Properties.RoleDefinitionId, roleId) {
+								managedClusterAdmins.Admins = append(managedClusterAdmins.Admins, models.ManagedClusterAdmin{
+									Admin:            item.Ok,
+									BuiltInRoleId:    roleId,
+									ManagedClusterId: id,
+								})
+								break
+							}
+						}
 					}
 				}
 				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
@@ -125,6 +161,11 @@ func listManagedClusterRoleAssignments(ctx context.Context, client client.AzureC
 					Data: managedClusterRoleAssignments,
 				}); !ok {
 					return
+				} else if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZManagedClusterAdmin,
+					Data: managedClusterAdmins,
+				}); !ok {
+					return
 				}
 				log.V(1).Info("finished listing managed cluster role assignments", "managedClusterId", id, "count", count)
 			}
@@ -139,3 +180,51 @@ func listManagedClusterRoleAssignments(ctx context.Context, client client.AzureC
 
 	return out
 }
+
+// managedClusterIdentities returns the identities of a managed cluster. The kubelet and add-on identities are attached
+// to its node pools, so every pod without a workload identity of its own can obtain tokens for them through the
+// instance metadata service. The control plane identity is the cluster's own.
+func managedClusterIdentities(managedCluster models.ManagedCluster) []models.ManagedClusterIdentity {
+	var identities []models.ManagedClusterIdentity
+	add := func(use enums.ManagedClusterIdentityUse, addonName, identityId, clientId, principalId string) {
+		identities = append(identities, models.ManagedClusterIdentity{
+			ManagedClusterId: managedCluster.Id,
+			Use:              use,
+			AddonName:        addonName,
+			IdentityId:       identityId,
+			ClientId:         clientId,
+			PrincipalId:      principalId,
+			TenantId:         managedCluster.TenantId,
+		})
+	}
+
+	if kubeletIdentity, ok := managedCluster.KubeletIdentity(); ok {
+		add(enums.ManagedClusterIdentityUseKubelet, "", kubeletIdentity.ResourceId, kubeletIdentity.ClientId, kubeletIdentity.ObjectId)
+	}
+
+	addonIdentities := managedCluster.AddonIdentities()
+	addonNames := make([]string, 0, len(addonIdentities))
+	for name := range addonIdentities {
+		addonNames = append(addonNames, name)
+	}
+	sort.Strings(addonNames)
+	for _, name := range addonNames {
+		identity := addonIdentities[name]
+		add(enums.ManagedClusterIdentityUseAddon, name, identity.ResourceId, identity.ClientId, identity.ObjectId)
+	}
+
+	if managedCluster.Identity.PrincipalId != "" {
+		add(enums.ManagedClusterIdentityUseControlPlane, "", "", "", managedCluster.Identity.PrincipalId)
+	}
+	identityIds := make([]string, 0, len(managedCluster.Identity.UserAssignedIdentities))
+	for identityId := range managedCluster.Identity.UserAssignedIdentities {
+		identityIds = append(identityIds, identityId)
+	}
+	sort.Strings(identityIds)
+	for _, identityId := range identityIds {
+		identity := managedCluster.Identity.UserAssignedIdentities[identityId]
+		add(enums.ManagedClusterIdentityUseControlPlane, "", identityId, identity.ClientId, identity.PrincipalId)
+	}
+
+	return identities
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListManagedClusterRoleAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		clusterId                  = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.ContainerService/managedClusters/baz"
+		mockManagedClustersChannel = make(chan interface{})
+		mockRoleAssignmentsChannel = make(chan client.AzureResult[azure.RoleAssignment])
+	)
+
+	roleAssignment := func(roleId string) client.AzureResult[azure.RoleAssignment] {
+		return client.AzureResult[azure.RoleAssignment]{
+			Ok: azure.RoleAssignment{
+				Properties: azure.RoleAssignmentPropertiesWithScope{
+					RoleDefinitionId: "/providers/Microsoft.Authorization/roleDefinitions/" + roleId,
+				},
+			},
+		}
+	}
+
+	mockClient.EXPECT().ListRoleAssignmentsForResource(gomock.Any(), clusterId, "", "").Return(mockRoleAssignmentsChannel).Times(1)
+	channel := listManagedClusterRoleAssignments(ctx, mockClient, mockManagedClustersChannel, nil)
+
+	go func() {
+		defer close(mockManagedClustersChannel)
+		mockManagedClustersChannel <- AzureWrapper{
+			Kind: enums.KindAZManagedCluster,
+			Data: models.ManagedCluster{
+				ManagedCluster: azure.ManagedCluster{
+					Entity:   azure.Entity{Id: clusterId},
+					Identity: azure.ManagedIdentity{Type: enums.IdentitySystemAssigned, PrincipalId: "control-plane"},
+					Properties: azure.ManagedClusterProperties{
+						AddonProfiles: map[string]azure.ManagedClusterAddonProfile{
+							"omsagent":    {Enabled: true, Identity: azure.ManagedClusterUserAssignedIdentity{ResourceId: "omsagent", ObjectId: "omsagent-principal"}},
+							"azurepolicy": {Enabled: false, Identity: azure.ManagedClusterUserAssignedIdentity{ResourceId: "azurepolicy", ObjectId: "azurepolicy-principal"}},
+							"httpRouting": {Enabled: true},
+						},
+						IdentityProfile: map[string]azure.ManagedClusterUserAssignedIdentity{
+							"kubeletidentity": {ResourceId: "kubelet", ObjectId: "principal"},
+						},
+					},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockRoleAssignmentsChannel)
+		mockRoleAssignmentsChannel <- roleAssignment(constants.AzureKubernetesServiceClusterAdminRoleID)
+		mockRoleAssignmentsChannel <- roleAssignment(constants.ReaderRoleID)
+	}()
+
+	var (
+		results    = map[enums.Kind]interface{}{}
+		identities []models.ManagedClusterIdentity
+	)
+	for result := range channel {
+		if wrapper, ok := result.(AzureWrapper); !ok {
+			t.Fatalf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+		} else if identity, ok := wrapper.Data.(models.ManagedClusterIdentity); ok {
+			identities = append(identities, identity)
+		} else {
+			results[wrapper.Kind] = wrapper.Data
+		}
+	}
+
+	want := []models.ManagedClusterIdentity{
+		{ManagedClusterId: clusterId, Use: enums.ManagedClusterIdentityUseKubelet, IdentityId: "kubelet", PrincipalId: "principal"},
+		{ManagedClusterId: clusterId, Use: enums.ManagedClusterIdentityUseAddon, AddonName: "omsagent", IdentityId: "omsagent", PrincipalId: "omsagent-principal"},
+		{ManagedClusterId: clusterId, Use: enums.ManagedClusterIdentityUseControlPlane, PrincipalId: "control-plane"},
+	}
+	if !reflect.DeepEqual(identities, want) {
+		t.Errorf("got identities %v, want %v", identities, want)
+	}
+
+	if data, ok := results[enums.KindAZManagedClusterRoleAssignment].(models.AzureRoleAssignments); !ok {
+		t.Errorf("got %v, want role assignments", results)
+	} else if len(data.RoleAssignments) != 2 {
+		t.Errorf("got %d role assignments, want 2", len(data.RoleAssignments))
+	}
+
+	if data, ok := results[enums.KindAZManagedClusterAdmin].(models.ManagedClusterAdmins); !ok {
+		t.Errorf("got %v, want cluster admins", results)
+	} else if len(data.Admins) != 1 || data.Admins[0].BuiltInRoleId != constants.AzureKubernetesServiceClusterAdminRoleID {
+		t.Errorf("got %v, want a single cluster admin", data.Admins)
+	}
+}
@@ -105,6 +105,12 @@ func listManagedClusters(ctx context.Context, client client.AzureClient, subscri
 							SubscriptionId:  "/subscriptions/" + id,
 							ResourceGroupId: item.Ok.ResourceGroupId(),
 							TenantId:        client.TenantInfo().TenantId,
+
+							LocalAccountsDisabled: item.Ok.Properties.DisableLocalAccounts,
+							EntraIntegrated:       item.Ok.Properties.AadProfile.Managed,
+							AzureRBACEnabled:      item.Ok.Properties.AadProfile.EnableAzureRBAC,
+							PublicAPIServer:       item.Ok.PublicAPIServer(),
+							AuthorizedIPRanges:    item.Ok.Properties.ApiServerAccessProfile.AuthorizedIPRanges,
 						}
 						log.V(2).Info("found managed cluster", "managedCluster", managedCluster)
 						count++
@@ -0,0 +1,133 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listManagedIdentityFederatedCredentialsCmd)
+}
+
+var listManagedIdentityFederatedCredentialsCmd = &cobra.Command{
+	Use:          "managed-identity-federated-credentials",
+	Long:         "Lists Azure User-Assigned Managed Identity Federated Identity Credentials",
+	Run:          listManagedIdentityFederatedCredentialsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listManagedIdentityFederatedCredentialsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure managed identity federated identity credentials...")
+	start := time.Now()
+	userAssignedIdentities := listUserAssignedIdentities(ctx, azClient, listSubscriptions(ctx, azClient))
+	stream := listManagedIdentityFederatedCredentials(ctx, azClient, userAssignedIdentities)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listManagedIdentityFederatedCredentials(ctx context.Context, client client.AzureClient, userAssignedIdentities <-chan interface{}) <-chan interface{} {
+	var (
+		out        = make(chan interface{})
+		identities = make(chan models.UserAssignedIdentity)
+		streams    = pipeline.Demux(ctx.Done(), identities, config.ColStreamCount.Value().(int))
+		wg         sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(identities)
+		for result := range pipeline.OrDone(ctx.Done(), userAssignedIdentities) {
+			if identity, ok := result.(AzureWrapper).Data.(models.UserAssignedIdentity); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating managed identity federated identity credentials", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), identities, identity); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for identity := range stream {
+				var (
+					data = models.ManagedIdentityFederatedCredentials{
+						IdentityId:      identity.Id,
+						ClientId:        identity.Properties.ClientId,
+						PrincipalId:     identity.Properties.PrincipalId,
+						SubscriptionId:  identity.SubscriptionId,
+						ResourceGroupId: identity.ResourceGroupId,
+						TenantId:        identity.TenantId,
+					}
+					count = 0
+				)
+				for item := range client.ListAzureUserAssignedIdentityFederatedIdentityCredentials(ctx, identity.Id) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing federated identity credentials for this user-assigned identity", "identityId", identity.Id)
+					} else {
+						log.V(2).Info("found managed identity federated identity credential", "federatedIdentityCredential", item.Ok)
+						count++
+						data.FederatedCredentials = append(data.FederatedCredentials, item.Ok)
+					}
+				}
+
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZIdentityFederatedCredential,
+					Data: data,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing managed identity federated identity credentials", "identityId", identity.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all managed identity federated identity credentials")
+	}()
+
+	return out
+}
@@ -51,10 +51,11 @@ func listManagementGroupOwnersCmdImpl(cmd *cobra.Command, args []string) {
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure management group owners...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	managementGroups := listManagementGroups(ctx, azClient)
 	roleAssignments := listManagementGroupRoleAssignments(ctx, azClient, managementGroups)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
-	stream := listManagementGroupOwners(ctx, roleAssignments)
+	stream := listManagementGroupOwners(ctx, roleAssignments, roleDefinitions)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
@@ -63,9 +64,10 @@ func listManagementGroupOwnersCmdImpl(cmd *cobra.Command, args []string) {
 func listManagementGroupOwners(
 	ctx context.Context,
 	roleAssignments <-chan azureWrapper[models.ManagementGroupRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
 ) <-chan any {
 	return pipeline.Map(ctx.Done(), roleAssignments, func(ra azureWrapper[models.ManagementGroupRoleAssignments]) any {
-		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, mgmtGroupRoleAssignmentFilter(constants.OwnerRoleID))
+		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, mgmtGroupRoleAssignmentFilter(roleDefinitions, constants.OwnerRoleID))
 		owners := internal.Map(filteredAssignments, func(ra models.ManagementGroupRoleAssignment) models.ManagementGroupOwner {
 			return models.ManagementGroupOwner{
 				Owner:             ra.RoleAssignment,
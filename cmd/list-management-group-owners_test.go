@@ -43,7 +43,7 @@ func TestListManagementGroupOwners(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan azureWrapper[models.ManagementGroupRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listManagementGroupOwners(ctx, mockRoleAssignmentsChannel)
+	channel := listManagementGroupOwners(ctx, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
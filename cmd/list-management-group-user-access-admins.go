@@ -51,10 +51,11 @@ func listManagementGroupUserAccessAdminsCmdImpl(cmd *cobra.Command, args []strin
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure management group user access admins...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	managementGroups := listManagementGroups(ctx, azClient)
 	roleAssignments := listManagementGroupRoleAssignments(ctx, azClient, managementGroups)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
-	stream := listManagementGroupUserAccessAdmins(ctx, roleAssignments)
+	stream := listManagementGroupUserAccessAdmins(ctx, roleAssignments, roleDefinitions)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
@@ -63,9 +64,10 @@ func listManagementGroupUserAccessAdminsCmdImpl(cmd *cobra.Command, args []strin
 func listManagementGroupUserAccessAdmins(
 	ctx context.Context,
 	roleAssignments <-chan azureWrapper[models.ManagementGroupRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
 ) <-chan any {
 	return pipeline.Map(ctx.Done(), roleAssignments, func(ra azureWrapper[models.ManagementGroupRoleAssignments]) any {
-		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, mgmtGroupRoleAssignmentFilter(constants.UserAccessAdminRoleID))
+		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, mgmtGroupRoleAssignmentFilter(roleDefinitions, constants.UserAccessAdminRoleID))
 		uaas := internal.Map(filteredAssignments, func(ra models.ManagementGroupRoleAssignment) models.ManagementGroupUserAccessAdmin {
 			return models.ManagementGroupUserAccessAdmin{
 				UserAccessAdmin:   ra.RoleAssignment,
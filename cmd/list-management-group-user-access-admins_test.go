@@ -43,7 +43,7 @@ func TestListManagementGroupUserAccessAdmins(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan azureWrapper[models.ManagementGroupRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listManagementGroupUserAccessAdmins(ctx, mockRoleAssignmentsChannel)
+	channel := listManagementGroupUserAccessAdmins(ctx, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
@@ -0,0 +1,127 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listNetworkInterfacesCmd)
+}
+
+var listNetworkInterfacesCmd = &cobra.Command{
+	Use:          "network-interfaces",
+	Long:         "Lists Azure Network Interfaces",
+	Run:          listNetworkInterfacesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listNetworkInterfacesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure network interfaces...")
+	start := time.Now()
+	stream := listNetworkInterfaces(ctx, azClient, listSubscriptions(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+func listNetworkInterfaces(ctx context.Context, client client.AzureClient, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan string)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+		for result := range pipeline.OrDone(ctx.Done(), subscriptions) {
+			if subscription, ok := result.(AzureWrapper).Data.(models.Subscription); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating network interfaces", "result", result)
+				return
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, subscription.SubscriptionId); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzureNetworkInterfaces(ctx, id, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing network interfaces for this subscription", "subscriptionId", id)
+					} else {
+						networkInterface := models.NetworkInterface{
+							NetworkInterface: item.Ok,
+							SubscriptionId:   "/subscriptions/" + id,
+							ResourceGroupId:  item.Ok.ResourceGroupId(),
+							TenantId:         client.TenantInfo().TenantId,
+						}
+						log.V(2).Info("found network interface", "networkInterface", networkInterface)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZNetworkInterface,
+							Data: networkInterface,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing network interfaces", "subscriptionId", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all network interfaces")
+	}()
+
+	return out
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listOAuth2PermissionGrantsCmd)
+}
+
+var listOAuth2PermissionGrantsCmd = &cobra.Command{
+	Use:          "oauth2-permission-grants",
+	Long:         "Lists Azure AD Delegated OAuth2 Permission Grants",
+	Run:          listOAuth2PermissionGrantsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listOAuth2PermissionGrantsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad oauth2 permission grants...")
+	start := time.Now()
+	stream := listOAuth2PermissionGrants(ctx, azClient)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listOAuth2PermissionGrants lists both tenant-wide (AllPrincipals) and per-user (Principal) delegated permission grants
+func listOAuth2PermissionGrants(ctx context.Context, client client.AzureClient) <-chan interface{} {
+	out := make(chan interface{})
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+		count := 0
+		for item := range client.ListAzureADOAuth2PermissionGrants(ctx, query.GraphParams{}) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing oauth2 permission grants")
+				return
+			} else {
+				log.V(2).Info("found oauth2 permission grant", "oauth2PermissionGrant", item)
+				count++
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZOAuth2PermissionGrant,
+					Data: models.OAuth2PermissionGrant{
+						OAuth2PermissionGrant: item.Ok,
+						Scopes:                strings.Fields(item.Ok.Scope),
+						TenantId:              client.TenantInfo().TenantId,
+					},
+				}); !ok {
+					return
+				}
+			}
+		}
+		log.Info("finished listing all oauth2 permission grants", "count", count)
+	}()
+
+	return out
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListOAuth2PermissionGrants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockChannel := make(chan client.AzureResult[azure.OAuth2PermissionGrant])
+	mockTenant := azure.Tenant{}
+	mockError := fmt.Errorf("I'm an error")
+	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
+	mockClient.EXPECT().ListAzureADOAuth2PermissionGrants(gomock.Any(), gomock.Any()).Return(mockChannel)
+
+	go func() {
+		defer close(mockChannel)
+		mockChannel <- client.AzureResult[azure.OAuth2PermissionGrant]{
+			Ok: azure.OAuth2PermissionGrant{
+				ConsentType: "AllPrincipals",
+				Scope:       " Mail.ReadWrite  User.Read ",
+			},
+		}
+		mockChannel <- client.AzureResult[azure.OAuth2PermissionGrant]{
+			Error: mockError,
+		}
+		mockChannel <- client.AzureResult[azure.OAuth2PermissionGrant]{
+			Ok: azure.OAuth2PermissionGrant{},
+		}
+	}()
+
+	channel := listOAuth2PermissionGrants(ctx, mockClient)
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.OAuth2PermissionGrant); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.OAuth2PermissionGrant{})
+	} else if len(data.Scopes) != 2 {
+		t.Errorf("got %v, want %v", len(data.Scopes), 2)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close from an error result but it did not")
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listPolicyAssignmentsCmd)
+}
+
+var listPolicyAssignmentsCmd = &cobra.Command{
+	Use:          "policy-assignments",
+	Long:         "Lists Azure Policy Assignments and the Policy Definitions they reference",
+	Run:          listPolicyAssignmentsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listPolicyAssignmentsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure policy assignments...")
+	start := time.Now()
+	managementGroups := listManagementGroups(ctx, azClient)
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listPolicyAssignments(ctx, azClient, managementGroups, subscriptions)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listPolicyAssignments lists the policy assignments made at each management group and subscription, including those
+// made at resource groups and resources within a subscription. Each policy definition or policy set definition
+// referenced by an assignment, and each member of a referenced policy set, is emitted once.
+func listPolicyAssignments(ctx context.Context, client client.AzureClient, managementGroups, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), listRBACScopes(ctx, managementGroups, subscriptions), config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		seen    = make(map[string]struct{})
+	)
+
+	// sendPolicyDefinition fetches and emits a policy definition the first time it is referenced
+	var sendPolicyDefinition func(id string) bool
+	sendPolicyDefinition = func(id string) bool {
+		mu.Lock()
+		_, duplicate := seen[strings.ToLower(id)]
+		seen[strings.ToLower(id)] = struct{}{}
+		mu.Unlock()
+
+		if duplicate || id == "" {
+			return true
+		} else if definition, err := client.GetAzurePolicyDefinition(ctx, id); err != nil {
+			log.Error(err, "unable to continue processing this policy definition", "policyDefinitionId", id)
+			return true
+		} else {
+			effect, roleDefinitionIds := definition.Effect()
+			log.V(2).Info("found policy definition", "policyDefinition", definition)
+			if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+				Kind: enums.KindAZPolicyDefinition,
+				Data: models.PolicyDefinition{
+					PolicyDefinition:  definition,
+					Effect:            effect,
+					RoleDefinitionIds: roleDefinitionIds,
+					TenantId:          client.TenantInfo().TenantId,
+				},
+			}); !ok {
+				return false
+			}
+
+			for _, reference := range definition.Properties.PolicyDefinitions {
+				if ok := sendPolicyDefinition(reference.PolicyDefinitionId); !ok {
+					return false
+				}
+			}
+			return true
+		}
+	}
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				var (
+					params = query.RMParams{}
+					count  = 0
+				)
+				// Listing at a subscription also returns the assignments made beneath it, whereas management groups are
+				// only listed at their exact scope
+				if strings.HasPrefix(strings.ToLower(id), "/providers/microsoft.management/managementgroups/") {
+					params.Filter = "atExactScope()"
+				}
+				for item := range client.ListAzurePolicyAssignments(ctx, id, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing policy assignments for this scope", "scope", id)
+					} else if item.Ok.IsWithinScope(id) {
+						log.V(2).Info("found policy assignment", "policyAssignment", item.Ok)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZPolicyAssignment,
+							Data: models.PolicyAssignment{
+								PolicyAssignment: item.Ok,
+								TenantId:         client.TenantInfo().TenantId,
+							},
+						}); !ok {
+							return
+						} else if ok := sendPolicyDefinition(item.Ok.Properties.PolicyDefinitionId); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing policy assignments", "scope", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all policy assignments")
+	}()
+
+	return out
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListPolicyAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		managementGroupId = "/providers/Microsoft.Management/managementGroups/mg"
+		setDefinitionId   = "/providers/Microsoft.Authorization/policySetDefinitions/set"
+		definitionId      = "/providers/Microsoft.Authorization/policyDefinitions/dine"
+
+		mockManagementGroupsChannel  = make(chan interface{})
+		mockSubscriptionsChannel     = make(chan interface{})
+		mockPolicyAssignmentsChannel = make(chan client.AzureResult[azure.PolicyAssignment])
+	)
+
+	policyAssignment := func(scope string) client.AzureResult[azure.PolicyAssignment] {
+		return client.AzureResult[azure.PolicyAssignment]{
+			Ok: azure.PolicyAssignment{
+				Properties: azure.PolicyAssignmentProperties{
+					Scope:              scope,
+					PolicyDefinitionId: setDefinitionId,
+				},
+			},
+		}
+	}
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzurePolicyAssignments(gomock.Any(), managementGroupId, query.RMParams{Filter: "atExactScope()"}).Return(mockPolicyAssignmentsChannel).Times(1)
+	mockClient.EXPECT().GetAzurePolicyDefinition(gomock.Any(), setDefinitionId).Return(azure.PolicyDefinition{
+		Id:   setDefinitionId,
+		Type: "Microsoft.Authorization/policySetDefinitions",
+		Properties: azure.PolicyDefinitionProperties{
+			PolicyDefinitions: []azure.PolicyDefinitionReference{{PolicyDefinitionId: definitionId}},
+		},
+	}, nil).Times(1)
+	mockClient.EXPECT().GetAzurePolicyDefinition(gomock.Any(), definitionId).Return(azure.PolicyDefinition{
+		Id: definitionId,
+		Properties: azure.PolicyDefinitionProperties{
+			PolicyRule: json.RawMessage(`{"if":{},"then":{"effect":"deployIfNotExists","details":{"roleDefinitionIds":["/providers/Microsoft.Authorization/roleDefinitions/b24988ac-6180-42a0-ab88-20f7382dd24c"]}}}`),
+		},
+	}, nil).Times(1)
+	channel := listPolicyAssignments(ctx, mockClient, mockManagementGroupsChannel, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockManagementGroupsChannel)
+		mockManagementGroupsChannel <- AzureWrapper{
+			Data: models.ManagementGroup{ManagementGroup: azure.ManagementGroup{Entity: azure.Entity{Id: managementGroupId}}},
+		}
+	}()
+	close(mockSubscriptionsChannel)
+	go func() {
+		defer close(mockPolicyAssignmentsChannel)
+		mockPolicyAssignmentsChannel <- policyAssignment(managementGroupId)
+		mockPolicyAssignmentsChannel <- policyAssignment("/providers/Microsoft.Management/managementGroups/parent")
+		mockPolicyAssignmentsChannel <- policyAssignment(managementGroupId)
+	}()
+
+	var assignments, definitions int
+	for result := range channel {
+		switch result.(AzureWrapper).Kind {
+		case enums.KindAZPolicyAssignment:
+			assignments++
+		case enums.KindAZPolicyDefinition:
+			definitions++
+			if definition := result.(AzureWrapper).Data.(models.PolicyDefinition); definition.Id == definitionId {
+				if definition.Effect != "deployIfNotExists" || len(definition.RoleDefinitionIds) != 1 {
+					t.Errorf("got %v %v, want deployIfNotExists with one role definition", definition.Effect, definition.RoleDefinitionIds)
+				}
+			}
+		}
+	}
+
+	if assignments != 2 {
+		t.Errorf("got %v assignments, want %v", assignments, 2)
+	}
+	if definitions != 2 {
+		t.Errorf("got %v definitions, want %v", definitions, 2)
+	}
+}
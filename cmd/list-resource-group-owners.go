@@ -51,11 +51,12 @@ func listResourceGroupOwnersCmdImpl(cmd *cobra.Command, args []string) {
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure resource group owners...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	subscriptions := listSubscriptions(ctx, azClient)
 	resourceGroups := listResourceGroups(ctx, azClient, subscriptions)
 	roleAssignments := listResourceGroupRoleAssignments(ctx, azClient, resourceGroups)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
-	stream := listResourceGroupOwners(ctx, roleAssignments)
+	stream := listResourceGroupOwners(ctx, roleAssignments, roleDefinitions)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
@@ -64,9 +65,10 @@ func listResourceGroupOwnersCmdImpl(cmd *cobra.Command, args []string) {
 func listResourceGroupOwners(
 	ctx context.Context,
 	roleAssignments <-chan azureWrapper[models.ResourceGroupRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
 ) <-chan any {
 	return pipeline.Map(ctx.Done(), roleAssignments, func(ra azureWrapper[models.ResourceGroupRoleAssignments]) any {
-		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, rgRoleAssignmentFilter(constants.OwnerRoleID))
+		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, rgRoleAssignmentFilter(roleDefinitions, constants.OwnerRoleID))
 
 		owners := internal.Map(filteredAssignments, func(ra models.ResourceGroupRoleAssignment) models.ResourceGroupOwner {
 			return models.ResourceGroupOwner{
@@ -43,7 +43,7 @@ func TestListResourceGroupOwners(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan azureWrapper[models.ResourceGroupRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listResourceGroupOwners(ctx, mockRoleAssignmentsChannel)
+	channel := listResourceGroupOwners(ctx, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
@@ -43,7 +43,7 @@ func TestListResourceGroupUserAccessAdmins(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan azureWrapper[models.ResourceGroupRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listResourceGroupUserAccessAdmins(ctx, mockRoleAssignmentsChannel)
+	channel := listResourceGroupUserAccessAdmins(ctx, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
@@ -0,0 +1,120 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listResourceRoleEligibilityScheduleInstancesCmd)
+}
+
+var listResourceRoleEligibilityScheduleInstancesCmd = &cobra.Command{
+	Use:          "resource-role-eligibility-schedule-instances",
+	Long:         "Lists Azure RBAC Role Eligibility Schedule Instances",
+	Run:          listResourceRoleEligibilityScheduleInstancesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listResourceRoleEligibilityScheduleInstancesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure rbac role eligibility schedule instances...")
+	start := time.Now()
+	managementGroups := listManagementGroups(ctx, azClient)
+	subscriptions := listSubscriptions(ctx, azClient)
+	stream := listResourceRoleEligibilityScheduleInstances(ctx, azClient, managementGroups, subscriptions)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listResourceRoleEligibilityScheduleInstances lists the Azure RBAC PIM eligibilities at each management group and
+// subscription scope. Listing a subscription also yields the eligibilities of its resource groups and resources, so
+// each instance is only reported by the scope that contains it to avoid emitting inherited instances more than once.
+func listResourceRoleEligibilityScheduleInstances(ctx context.Context, client client.AzureClient, managementGroups, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), listRBACScopes(ctx, managementGroups, subscriptions), config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				var (
+					params = query.RMParams{}
+					count  = 0
+				)
+				// Listing at a management group without a filter also returns every instance beneath it
+				if strings.HasPrefix(strings.ToLower(id), "/providers/microsoft.management/managementgroups/") {
+					params.Filter = "atScope()"
+				}
+				for item := range client.ListAzureRoleEligibilityScheduleInstances(ctx, id, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing role eligibility schedule instances for this scope", "scope", id)
+					} else if item.Ok.IsWithinScope(id) {
+						log.V(2).Info("found role eligibility schedule instance", "roleEligibilityScheduleInstance", item.Ok)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZResourceRoleEligibilityInstance,
+							Data: models.ResourceRoleEligibilityScheduleInstance{
+								RoleEligibilityScheduleInstance: item.Ok,
+								TenantId:                        client.TenantInfo().TenantId,
+							},
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing role eligibility schedule instances", "scope", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all role eligibility schedule instances")
+	}()
+
+	return out
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListResourceRoleEligibilityScheduleInstances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		managementGroupId = "/providers/Microsoft.Management/managementGroups/mg"
+		subscriptionId    = "/subscriptions/00000000-0000-0000-0000-000000000000"
+
+		mockManagementGroupsChannel         = make(chan interface{})
+		mockSubscriptionsChannel            = make(chan interface{})
+		mockManagementGroupInstancesChannel = make(chan client.AzureResult[azure.RoleEligibilityScheduleInstance])
+		mockSubscriptionInstancesChannel    = make(chan client.AzureResult[azure.RoleEligibilityScheduleInstance])
+	)
+
+	instanceAt := func(scope string) client.AzureResult[azure.RoleEligibilityScheduleInstance] {
+		return client.AzureResult[azure.RoleEligibilityScheduleInstance]{
+			Ok: azure.RoleEligibilityScheduleInstance{
+				Properties: azure.RoleEligibilityScheduleInstanceProperties{Scope: scope},
+			},
+		}
+	}
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureRoleEligibilityScheduleInstances(gomock.Any(), managementGroupId, query.RMParams{Filter: "atScope()"}).Return(mockManagementGroupInstancesChannel).Times(1)
+	mockClient.EXPECT().ListAzureRoleEligibilityScheduleInstances(gomock.Any(), subscriptionId, query.RMParams{}).Return(mockSubscriptionInstancesChannel).Times(1)
+	channel := listResourceRoleEligibilityScheduleInstances(ctx, mockClient, mockManagementGroupsChannel, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockManagementGroupsChannel)
+		mockManagementGroupsChannel <- AzureWrapper{
+			Data: models.ManagementGroup{ManagementGroup: azure.ManagementGroup{Entity: azure.Entity{Id: managementGroupId}}},
+		}
+	}()
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{Subscription: azure.Subscription{Entity: azure.Entity{Id: subscriptionId}}},
+		}
+	}()
+	go func() {
+		defer close(mockManagementGroupInstancesChannel)
+		mockManagementGroupInstancesChannel <- instanceAt(managementGroupId)
+		mockManagementGroupInstancesChannel <- instanceAt("/")
+	}()
+	go func() {
+		defer close(mockSubscriptionInstancesChannel)
+		mockSubscriptionInstancesChannel <- instanceAt(subscriptionId)
+		mockSubscriptionInstancesChannel <- instanceAt(subscriptionId + "/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm")
+		mockSubscriptionInstancesChannel <- instanceAt(managementGroupId)
+	}()
+
+	count := 0
+	for result := range channel {
+		if _, ok := result.(AzureWrapper).Data.(models.ResourceRoleEligibilityScheduleInstance); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.ResourceRoleEligibilityScheduleInstance{})
+		}
+		count++
+	}
+
+	if count != 3 {
+		t.Errorf("got %v, want %v", count, 3)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listResourceRoleManagementPolicyAssignmentsCmd)
+}
+
+var listResourceRoleManagementPolicyAssignmentsCmd = &cobra.Command{
+	Use:          "resource-role-management-policy-assignments",
+	Long:         "Lists Azure RBAC Role Management Policy Assignments",
+	Run:          listResourceRoleManagementPolicyAssignmentsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listResourceRoleManagementPolicyAssignmentsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure rbac role management policy assignments...")
+	start := time.Now()
+	managementGroups := listManagementGroups(ctx, azClient)
+	subscriptions := make(chan interface{})
+	subscriptions2 := make(chan interface{})
+	pipeline.Tee(ctx.Done(), listSubscriptions(ctx, azClient), subscriptions, subscriptions2)
+	resourceGroups := listResourceGroups(ctx, azClient, subscriptions2)
+	stream := listResourceRoleManagementPolicyAssignments(ctx, azClient, managementGroups, subscriptions, resourceGroups)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listResourceRoleManagementPolicyAssignments lists the PIM policies governing Azure RBAC roles at each management
+// group, subscription and resource group scope.
+func listResourceRoleManagementPolicyAssignments(ctx context.Context, client client.AzureClient, managementGroups, subscriptions, resourceGroups <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), listRBACScopes(ctx, managementGroups, subscriptions, resourceGroups), config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzureRoleManagementPolicyAssignments(ctx, id, query.RMParams{}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing role management policy assignments for this scope", "scope", id)
+					} else {
+						policyAssignment := formatResourceRoleManagementPolicyAssignment(item.Ok)
+						policyAssignment.TenantId = client.TenantInfo().TenantId
+
+						log.V(2).Info("found role management policy assignment", "roleManagementPolicyAssignment", policyAssignment)
+						count++
+						if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+							Kind: enums.KindAZResourceRoleManagementPolicy,
+							Data: policyAssignment,
+						}); !ok {
+							return
+						}
+					}
+				}
+				log.V(1).Info("finished listing role management policy assignments", "scope", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all role management policy assignments")
+	}()
+
+	return out
+}
+
+// formatResourceRoleManagementPolicyAssignment flattens the effective end user assignment rules of an Azure RBAC role
+// management policy assignment, mirroring formatRoleManagementPolicyAssignment for directory roles
+func formatResourceRoleManagementPolicyAssignment(assignment azure.RoleManagementPolicyAssignment) models.ResourceRoleManagementPolicyAssignment {
+	rmPolicyAssignment := models.ResourceRoleManagementPolicyAssignment{
+		RoleManagementPolicyAssignment: assignment,
+
+		Scope:            assignment.Properties.Scope,
+		RoleDefinitionId: assignment.Properties.RoleDefinitionId,
+	}
+
+	for _, rule := range assignment.Properties.EffectiveRules {
+		if rule.Target.Caller != "EndUser" || rule.Target.Level != "Assignment" {
+			continue
+		}
+
+		switch rule.RuleType {
+		case "RoleManagementPolicyApprovalRule":
+			if rule.Setting == nil {
+				continue
+			}
+			for _, approvalStage := range rule.Setting.ApprovalStages {
+				for _, approver := range approvalStage.PrimaryApprovers {
+					switch approver.UserType {
+					case "User":
+						rmPolicyAssignment.EndUserAssignmentUserApprovers = append(rmPolicyAssignment.EndUserAssignmentUserApprovers, approver.Id)
+					case "Group":
+						rmPolicyAssignment.EndUserAssignmentGroupApprovers = append(rmPolicyAssignment.EndUserAssignmentGroupApprovers, approver.Id)
+					}
+				}
+			}
+			rmPolicyAssignment.EndUserAssignmentRequiresApproval = rule.Setting.IsApprovalRequired
+		case "RoleManagementPolicyEnablementRule":
+			rmPolicyAssignment.EndUserAssignmentRequiresMFA = contains(rule.EnabledRules, "MultiFactorAuthentication")
+			rmPolicyAssignment.EndUserAssignmentRequiresJustification = contains(rule.EnabledRules, "Justification")
+			rmPolicyAssignment.EndUserAssignmentRequiresTicketInformation = contains(rule.EnabledRules, "Ticketing")
+		case "RoleManagementPolicyAuthenticationContextRule":
+			rmPolicyAssignment.EndUserAssignmentRequiresCAPAuthenticationContext = rule.IsEnabled
+		}
+	}
+
+	return rmPolicyAssignment
+}
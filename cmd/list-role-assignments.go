@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"time"
 
@@ -108,8 +109,9 @@ func listRoleAssignments(ctx context.Context, client client.AzureClient, roles <
 					} else {
 						log.V(2).Info("found role assignment", "roleAssignments", item)
 						count++
-						// To ensure proper linking to AZApp nodes we want to supply the AppId instead when role assignments are app specific scoped
-						if item.Ok.DirectoryScopeId != "/" {
+						// To ensure proper linking to AZApp nodes we want to supply the AppId instead when role assignments are app specific scoped.
+						// Administrative unit scoped role assignments are left as-is so they are not mistaken for tenant-wide ones.
+						if item.Ok.DirectoryScopeId != "/" && !strings.HasPrefix(item.Ok.DirectoryScopeId, "/administrativeUnits/") {
 							item.Ok.DirectoryScopeId = fmt.Sprintf("/%s", item.Ok.DirectoryScope.AppId)
 						}
 						roleAssignments.RoleAssignments = append(roleAssignments.RoleAssignments, item.Ok)
@@ -0,0 +1,312 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listRoleDefinitionsCmd)
+}
+
+var listRoleDefinitionsCmd = &cobra.Command{
+	Use:          "role-definitions",
+	Long:         "Lists Azure RBAC Role Definitions",
+	Run:          listRoleDefinitionsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listRoleDefinitionsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure rbac role definitions...")
+	start := time.Now()
+	stream := listAllRoleDefinitions(ctx, azClient)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listAllRoleDefinitions lists the built-in role definitions and the custom role definitions of every management group
+// and subscription. It enumerates
+// its own scopes so that consumers of the resulting index never wait on the resource pipeline they classify.
+func listAllRoleDefinitions(ctx context.Context, client client.AzureClient) <-chan interface{} {
+	return listRoleDefinitions(ctx, client, listManagementGroups(ctx, client), listSubscriptions(ctx, client))
+}
+
+// listRoleDefinitions lists the built-in role definitions once, followed by the custom role definitions assignable at
+// each management group and subscription. Inherited custom roles are visible from many scopes so each definition is
+// only emitted once. Every built-in definition is emitted before any custom definition, which roleDefinitionIndex
+// relies on to classify built-in roles without waiting on the custom ones.
+func listRoleDefinitions(ctx context.Context, client client.AzureClient, managementGroups, subscriptions <-chan interface{}) <-chan interface{} {
+	var (
+		out      = make(chan interface{})
+		builtIns = make(chan struct{})
+		streams  = pipeline.Demux(ctx.Done(), listRBACScopes(ctx, managementGroups, subscriptions), config.ColStreamCount.Value().(int))
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		seen     = make(map[string]struct{})
+	)
+
+	send := func(roleDefinition azure.RoleDefinition) bool {
+		mu.Lock()
+		_, duplicate := seen[strings.ToLower(roleDefinition.Name)]
+		seen[strings.ToLower(roleDefinition.Name)] = struct{}{}
+		mu.Unlock()
+
+		if duplicate {
+			return true
+		}
+		log.V(2).Info("found role definition", "roleDefinition", roleDefinition)
+		return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+			Kind: enums.KindAZRoleDefinition,
+			Data: models.RoleDefinition{
+				RoleDefinition: roleDefinition,
+				TenantId:       client.TenantInfo().TenantId,
+			},
+		})
+	}
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(builtIns)
+
+		count := 0
+		for item := range client.ListAzureRoleDefinitions(ctx, "", query.RMParams{Filter: "type eq 'BuiltInRole'"}) {
+			if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing built-in role definitions")
+			} else if ok := send(item.Ok); !ok {
+				return
+			} else {
+				count++
+			}
+		}
+		log.V(1).Info("finished listing built-in role definitions", "count", count)
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+
+			select {
+			case <-builtIns:
+			case <-ctx.Done():
+				return
+			}
+
+			for id := range stream {
+				count := 0
+				for item := range client.ListAzureRoleDefinitions(ctx, id, query.RMParams{Filter: "type eq 'CustomRole'"}) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing role definitions for this scope", "scope", id)
+					} else if ok := send(item.Ok); !ok {
+						return
+					} else {
+						count++
+					}
+				}
+				log.V(1).Info("finished listing custom role definitions", "scope", id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		<-builtIns
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all role definitions")
+	}()
+
+	return out
+}
+
+// roleDefinitionIndex resolves Azure RBAC role definition ids to their definitions so that role assignments can be
+// classified by the permissions they grant rather than by built-in role id alone.
+type roleDefinitionIndex struct {
+	done <-chan struct{}
+
+	// closed once every built-in definition has been indexed
+	builtInsReady chan struct{}
+	builtIns      map[string]azure.RoleDefinition
+
+	// closed once the stream has been drained
+	ready       chan struct{}
+	definitions map[string]azure.RoleDefinition
+}
+
+// newRoleDefinitionIndex drains roleDefinitions in the background. The stream is expected to carry every built-in
+// definition before any custom one, as listRoleDefinitions does, so that lookups of built-in roles only block until the
+// first custom definition arrives. Lookups of other roles block until the stream has been drained.
+func newRoleDefinitionIndex(ctx context.Context, roleDefinitions <-chan interface{}) *roleDefinitionIndex {
+	index := &roleDefinitionIndex{
+		done:          ctx.Done(),
+		builtInsReady: make(chan struct{}),
+		builtIns:      make(map[string]azure.RoleDefinition),
+		ready:         make(chan struct{}),
+		definitions:   make(map[string]azure.RoleDefinition),
+	}
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(index.ready)
+
+		builtIns := true
+		for result := range pipeline.OrDone(ctx.Done(), roleDefinitions) {
+			if roleDefinition, ok := result.(AzureWrapper).Data.(models.RoleDefinition); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to index this role definition", "result", result)
+				continue
+			} else if builtIns && roleDefinition.Properties.Type == "BuiltInRole" {
+				index.builtIns[strings.ToLower(roleDefinition.Name)] = roleDefinition.RoleDefinition
+			} else {
+				if builtIns {
+					builtIns = false
+					close(index.builtInsReady)
+				}
+				index.definitions[strings.ToLower(roleDefinition.Name)] = roleDefinition.RoleDefinition
+			}
+		}
+
+		if builtIns {
+			close(index.builtInsReady)
+		}
+	}()
+
+	return index
+}
+
+// grants reports whether the role definition referenced by roleDefinitionId is, or is equivalent to, the built-in role
+// identified by builtInRoleId. A nil index only matches the built-in role itself.
+func (s *roleDefinitionIndex) grants(roleDefinitionId, builtInRoleId string) bool {
+	id := path.Base(roleDefinitionId)
+	if strings.EqualFold(id, builtInRoleId) {
+		return true
+	} else if s == nil {
+		return false
+	}
+
+	select {
+	case <-s.builtInsReady:
+	case <-s.done:
+		return false
+	}
+
+	if definition, ok := s.builtIns[strings.ToLower(id)]; ok {
+		return isEquivalentRole(definition, builtInRoleId)
+	}
+
+	select {
+	case <-s.ready:
+	case <-s.done:
+		return false
+	}
+
+	if definition, ok := s.definitions[strings.ToLower(id)]; !ok {
+		return false
+	} else {
+		return isEquivalentRole(definition, builtInRoleId)
+	}
+}
+
+// Write actions across the resource providers whose resources carry attack paths. A role that still grants all of them
+// once its NotActions are applied can modify resources as broadly as Contributor, whether it grants "*" or "*/write".
+var contributorWriteActions = []string{
+	"Microsoft.Automation/automationAccounts/write",
+	"Microsoft.Compute/virtualMachines/write",
+	"Microsoft.Compute/virtualMachineScaleSets/write",
+	"Microsoft.ContainerRegistry/registries/write",
+	"Microsoft.ContainerService/managedClusters/write",
+	"Microsoft.KeyVault/vaults/write",
+	"Microsoft.Logic/workflows/write",
+	"Microsoft.Resources/deployments/write",
+	"Microsoft.Storage/storageAccounts/write",
+	"Microsoft.Web/sites/write",
+}
+
+// isEquivalentRole reports whether definition grants the permissions that give builtInRoleId its attack path
+// significance. The classes are exclusive in the same way the built-in roles are, e.g. a role that is equivalent to
+// Owner is not also reported as a Contributor or User Access Administrator.
+func isEquivalentRole(definition azure.RoleDefinition, builtInRoleId string) bool {
+	var (
+		writeResources       = grantsAllActions(definition, contributorWriteActions)
+		writeRoleAssignments = definition.GrantsAction("Microsoft.Authorization/roleAssignments/write")
+		blobs                = "Microsoft.Storage/storageAccounts/blobServices/containers/blobs/"
+	)
+
+	switch builtInRoleId {
+	case constants.OwnerRoleID:
+		return writeResources && writeRoleAssignments
+	case constants.UserAccessAdminRoleID:
+		return writeRoleAssignments && !writeResources
+	case constants.ContributorRoleID:
+		return writeResources && !writeRoleAssignments
+	case constants.KeyVaultContributorRoleID:
+		return definition.GrantsAction("Microsoft.KeyVault/vaults/accessPolicies/write") && !writeResources
+	case constants.VirtualMachineContributorRoleID:
+		return definition.GrantsAction("Microsoft.Compute/virtualMachines/runCommand/action") && !writeResources
+	case constants.VirtualMachineAdministratorLoginRoleID:
+		return definition.GrantsDataAction("Microsoft.Compute/virtualMachines/loginAsAdmin/action")
+	case constants.AzureKubernetesServiceClusterAdminRoleID:
+		return definition.GrantsAction("Microsoft.ContainerService/managedClusters/listClusterAdminCredential/action") && !writeResources
+	case constants.AzureKubernetesServiceRBACClusterAdminRoleID:
+		return definition.GrantsDataAction("Microsoft.ContainerService/managedClusters/namespaces/write") &&
+			definition.GrantsDataAction("Microsoft.ContainerService/managedClusters/rbac.authorization.k8s.io/clusterrolebindings/write")
+	case constants.StorageBlobDataOwnerRoleID:
+		return definition.GrantsDataAction(blobs + "modifyPermissions/action")
+	case constants.StorageBlobDataContributorRoleID:
+		return definition.GrantsDataAction(blobs+"write") && !definition.GrantsDataAction(blobs+"modifyPermissions/action")
+	case constants.StorageBlobDataReaderRoleID:
+		return definition.GrantsDataAction(blobs+"read") && !definition.GrantsDataAction(blobs+"write")
+	default:
+		return false
+	}
+}
+
+func grantsAllActions(definition azure.RoleDefinition, actions []string) bool {
+	for _, action := range actions {
+		if !definition.GrantsAction(action) {
+			return false
+		}
+	}
+	return true
+}
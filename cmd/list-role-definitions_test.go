@@ -0,0 +1,224 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListRoleDefinitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	mockManagementGroupsChannel := make(chan interface{})
+	mockSubscriptionsChannel := make(chan interface{})
+	mockRoleDefinitionsChannel := make(chan client.AzureResult[azure.RoleDefinition])
+	mockRoleDefinitionsChannel2 := make(chan client.AzureResult[azure.RoleDefinition])
+	mockBuiltInRoleDefinitionsChannel := make(chan client.AzureResult[azure.RoleDefinition])
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureRoleDefinitions(gomock.Any(), "", query.RMParams{Filter: "type eq 'BuiltInRole'"}).Return(mockBuiltInRoleDefinitionsChannel).Times(1)
+	mockClient.EXPECT().ListAzureRoleDefinitions(gomock.Any(), gomock.Any(), query.RMParams{Filter: "type eq 'CustomRole'"}).Return(mockRoleDefinitionsChannel).Times(1)
+	mockClient.EXPECT().ListAzureRoleDefinitions(gomock.Any(), gomock.Any(), query.RMParams{Filter: "type eq 'CustomRole'"}).Return(mockRoleDefinitionsChannel2).Times(1)
+	channel := listRoleDefinitions(ctx, mockClient, mockManagementGroupsChannel, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockManagementGroupsChannel)
+		mockManagementGroupsChannel <- AzureWrapper{
+			Data: models.ManagementGroup{},
+		}
+	}()
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{},
+		}
+	}()
+	go func() {
+		defer close(mockBuiltInRoleDefinitionsChannel)
+		mockBuiltInRoleDefinitionsChannel <- client.AzureResult[azure.RoleDefinition]{
+			Ok: azure.RoleDefinition{Name: constants.OwnerRoleID},
+		}
+	}()
+	go func() {
+		defer close(mockRoleDefinitionsChannel)
+		mockRoleDefinitionsChannel <- client.AzureResult[azure.RoleDefinition]{
+			Ok: azure.RoleDefinition{Name: "custom"},
+		}
+	}()
+	go func() {
+		defer close(mockRoleDefinitionsChannel2)
+		// custom roles inherited from a management group are visible from its subscriptions too
+		mockRoleDefinitionsChannel2 <- client.AzureResult[azure.RoleDefinition]{
+			Ok: azure.RoleDefinition{Name: "custom"},
+		}
+	}()
+
+	var names []string
+	for result := range channel {
+		names = append(names, result.(AzureWrapper).Data.(models.RoleDefinition).Name)
+	}
+
+	// built-in definitions come first
+	if want := []string{constants.OwnerRoleID, "custom"}; !slices.Equal(names, want) {
+		t.Errorf("got %v, want %v", names, want)
+	}
+}
+
+func TestRoleDefinitionIndex(t *testing.T) {
+	ctx := context.Background()
+
+	roleDefinitions := make(chan interface{})
+	index := newRoleDefinitionIndex(ctx, roleDefinitions)
+
+	roleDefinition := func(name string, permission azure.RoleDefinitionPermission) AzureWrapper {
+		return AzureWrapper{
+			Data: models.RoleDefinition{
+				RoleDefinition: azure.RoleDefinition{
+					Name: name,
+					Properties: azure.RoleDefinitionProperties{
+						Permissions: []azure.RoleDefinitionPermission{permission},
+					},
+				},
+			},
+		}
+	}
+
+	go func() {
+		defer close(roleDefinitions)
+		roleDefinitions <- roleDefinition("custom-owner", azure.RoleDefinitionPermission{
+			Actions: []string{"*"},
+		})
+		roleDefinitions <- roleDefinition("custom-contributor", azure.RoleDefinitionPermission{
+			Actions:    []string{"*"},
+			NotActions: []string{"Microsoft.Authorization/*/Write", "Microsoft.Authorization/*/Delete"},
+		})
+		roleDefinitions <- roleDefinition("custom-uaa", azure.RoleDefinitionPermission{
+			Actions: []string{"*/read", "Microsoft.Authorization/roleAssignments/*"},
+		})
+		roleDefinitions <- roleDefinition("custom-kv", azure.RoleDefinitionPermission{
+			Actions: []string{"Microsoft.KeyVault/*"},
+		})
+		roleDefinitions <- roleDefinition("custom-blob-writer", azure.RoleDefinitionPermission{
+			DataActions:    []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/*"},
+			NotDataActions: []string{"Microsoft.Storage/storageAccounts/blobServices/containers/blobs/modifyPermissions/action"},
+		})
+		roleDefinitions <- roleDefinition("custom-reader", azure.RoleDefinitionPermission{
+			Actions: []string{"*/read"},
+		})
+		roleDefinitions <- roleDefinition("custom-no-writes", azure.RoleDefinitionPermission{
+			Actions:    []string{"*"},
+			NotActions: []string{"*/write"},
+		})
+		// unexpected items are skipped without abandoning the stream
+		roleDefinitions <- AzureWrapper{Data: models.Subscription{}}
+		roleDefinitions <- roleDefinition("custom-writer", azure.RoleDefinitionPermission{
+			Actions: []string{"*/read", "*/write"},
+		})
+		roleDefinitions <- roleDefinition("custom-writer-without-authorization", azure.RoleDefinitionPermission{
+			Actions:    []string{"*/write"},
+			NotActions: []string{"Microsoft.Authorization/*"},
+		})
+	}()
+
+	testCases := []struct {
+		roleDefinitionId string
+		builtInRoleId    string
+		want             bool
+	}{
+		{"/providers/Microsoft.Authorization/roleDefinitions/" + constants.OwnerRoleID, constants.OwnerRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-owner", constants.OwnerRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-owner", constants.ContributorRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-owner", constants.UserAccessAdminRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-contributor", constants.ContributorRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-contributor", constants.OwnerRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-uaa", constants.UserAccessAdminRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-kv", constants.KeyVaultContributorRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-blob-writer", constants.StorageBlobDataContributorRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-blob-writer", constants.StorageBlobDataOwnerRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-blob-writer", constants.StorageBlobDataReaderRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-reader", constants.OwnerRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-no-writes", constants.OwnerRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-no-writes", constants.ContributorRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-no-writes", constants.UserAccessAdminRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-writer", constants.OwnerRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-writer", constants.ContributorRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-writer-without-authorization", constants.ContributorRoleID, true},
+		{"/providers/Microsoft.Authorization/roleDefinitions/custom-writer-without-authorization", constants.OwnerRoleID, false},
+		{"/providers/Microsoft.Authorization/roleDefinitions/unknown", constants.OwnerRoleID, false},
+	}
+
+	for _, testCase := range testCases {
+		if got := index.grants(testCase.roleDefinitionId, testCase.builtInRoleId); got != testCase.want {
+			t.Errorf("grants(%s, %s): got %v, want %v", testCase.roleDefinitionId, testCase.builtInRoleId, got, testCase.want)
+		}
+	}
+
+	var nilIndex *roleDefinitionIndex
+	if !nilIndex.grants(constants.OwnerRoleID, constants.OwnerRoleID) {
+		t.Error("nil index should match built-in role ids")
+	}
+}
+
+func TestRoleDefinitionIndex_BuiltIns(t *testing.T) {
+	ctx := context.Background()
+
+	roleDefinitions := make(chan interface{})
+	defer close(roleDefinitions)
+	index := newRoleDefinitionIndex(ctx, roleDefinitions)
+
+	roleDefinitions <- AzureWrapper{
+		Data: models.RoleDefinition{
+			RoleDefinition: azure.RoleDefinition{
+				Name: constants.ContributorRoleID,
+				Properties: azure.RoleDefinitionProperties{
+					Type: "BuiltInRole",
+					Permissions: []azure.RoleDefinitionPermission{{
+						Actions:    []string{"*"},
+						NotActions: []string{"Microsoft.Authorization/*/Write"},
+					}},
+				},
+			},
+		},
+	}
+	roleDefinitions <- AzureWrapper{
+		Data: models.RoleDefinition{RoleDefinition: azure.RoleDefinition{Name: "custom"}},
+	}
+
+	// built-in roles are classified while custom definitions are still being listed
+	if index.grants(constants.ContributorRoleID, constants.OwnerRoleID) {
+		t.Error("Contributor should not be equivalent to Owner")
+	}
+}
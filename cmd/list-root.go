@@ -31,7 +31,7 @@ import (
 )
 
 func init() {
-	config.Init(listRootCmd, append(config.AzureConfig, config.OutputFile))
+	config.Init(listRootCmd, append(config.AzureConfig, config.ListRootConfig...))
 	rootCmd.AddCommand(listRootCmd)
 }
 
@@ -66,5 +66,5 @@ func listAll(ctx context.Context, client client.AzureClient) <-chan interface{}
 		azureAD = listAllAD(ctx, client)
 		azureRM = listAllRM(ctx, client)
 	)
-	return pipeline.Mux(ctx.Done(), azureAD, azureRM)
+	return withGroupTransitiveMembers(ctx, client, pipeline.Mux(ctx.Done(), azureAD, azureRM))
 }
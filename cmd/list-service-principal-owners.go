@@ -92,12 +92,15 @@ func listServicePrincipalOwners(ctx context.Context, client client.AzureClient,
 			defer panicrecovery.PanicRecovery()
 			defer wg.Done()
 			for id := range stream {
-				var (
-					servicePrincipalOwners = models.ServicePrincipalOwners{
-						ServicePrincipalId: id,
-					}
-					count = 0
-				)
+				chunks := newRecordChunks(func(items []models.ServicePrincipalOwner) bool {
+					return pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZServicePrincipalOwner,
+						Data: models.ServicePrincipalOwners{
+							ServicePrincipalId: id,
+							Owners:             items,
+						},
+					})
+				})
 				for item := range client.ListAzureADServicePrincipalOwners(ctx, id, query.GraphParams{}) {
 					if item.Error != nil {
 						log.Error(item.Error, "unable to continue processing owners for this service principal", "servicePrincipalId", id)
@@ -107,17 +110,15 @@ func listServicePrincipalOwners(ctx context.Context, client client.AzureClient,
 							ServicePrincipalId: id,
 						}
 						log.V(2).Info("found service principal owner", "servicePrincipalOwner", servicePrincipalOwner)
-						count++
-						servicePrincipalOwners.Owners = append(servicePrincipalOwners.Owners, servicePrincipalOwner)
+						if ok := chunks.add(servicePrincipalOwner); !ok {
+							return
+						}
 					}
 				}
-				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
-					Kind: enums.KindAZServicePrincipalOwner,
-					Data: servicePrincipalOwners,
-				}); !ok {
+				if ok := chunks.flush(); !ok {
 					return
 				}
-				log.V(1).Info("finished listing service principal owners", "servicePrincipalId", id, "count", count)
+				log.V(1).Info("finished listing service principal owners", "servicePrincipalId", id, "count", chunks.count)
 			}
 		}()
 	}
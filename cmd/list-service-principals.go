@@ -25,8 +25,10 @@ import (
 
 	"github.com/bloodhoundad/azurehound/v2/client"
 	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/spf13/cobra"
@@ -64,21 +66,46 @@ func listServicePrincipals(ctx context.Context, client client.AzureClient) <-cha
 	go func() {
 		defer panicrecovery.PanicRecovery()
 		defer close(out)
+		// The sign-in activity report is read while the first page of service principals is requested
+		reports := make(chan map[string]azure.ServicePrincipalSignInActivity, 1)
+		if config.ColSignInActivity.Value().(bool) {
+			go func() {
+				defer panicrecovery.PanicRecovery()
+				reports <- listServicePrincipalSignInActivities(ctx, client)
+			}()
+		} else {
+			reports <- nil
+		}
+
+		var (
+			servicePrincipals = client.ListAzureADServicePrincipals(ctx, query.GraphParams{})
+			signInActivities  map[string]azure.ServicePrincipalSignInActivity
+		)
+		select {
+		case signInActivities = <-reports:
+		case <-ctx.Done():
+			return
+		}
+
 		count := 0
-		for item := range client.ListAzureADServicePrincipals(ctx, query.GraphParams{}) {
+		for item := range servicePrincipals {
 			if item.Error != nil {
 				log.Error(item.Error, "unable to continue processing service principals")
 				return
 			} else {
 				log.V(2).Info("found service principal", "servicePrincipal", item)
 				count++
+				servicePrincipal := models.ServicePrincipal{
+					ServicePrincipal: item.Ok,
+					TenantId:         client.TenantInfo().TenantId,
+					TenantName:       client.TenantInfo().DisplayName,
+				}
+				if activity, ok := signInActivities[item.Ok.AppId]; ok {
+					servicePrincipal.SignInActivity = &activity
+				}
 				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
 					Kind: enums.KindAZServicePrincipal,
-					Data: models.ServicePrincipal{
-						ServicePrincipal: item.Ok,
-						TenantId:         client.TenantInfo().TenantId,
-						TenantName:       client.TenantInfo().DisplayName,
-					},
+					Data: servicePrincipal,
 				}); !ok {
 					return
 				}
@@ -89,3 +116,37 @@ func listServicePrincipals(ctx context.Context, client client.AzureClient) <-cha
 
 	return out
 }
+
+// listServicePrincipalSignInActivities returns the sign-in activity of each application keyed by app id. The report
+// requires the AuditLog.Read.All permission and a P1 license, so a single activity is probed first; without them
+// service principals are listed without it.
+func listServicePrincipalSignInActivities(ctx context.Context, client client.AzureClient) map[string]azure.ServicePrincipalSignInActivity {
+	if !canReadServicePrincipalSignInActivity(ctx, client) {
+		return nil
+	}
+
+	activities := make(map[string]azure.ServicePrincipalSignInActivity)
+	for item := range client.ListAzureADServicePrincipalSignInActivities(ctx, query.GraphParams{}) {
+		if item.Error != nil {
+			log.Error(item.Error, "unable to continue processing service principal sign-in activity")
+		} else {
+			activities[item.Ok.AppId] = item.Ok
+		}
+	}
+	log.V(1).Info("finished listing service principal sign-in activity", "count", len(activities))
+	return activities
+}
+
+func canReadServicePrincipalSignInActivity(ctx context.Context, client client.AzureClient) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for item := range client.ListAzureADServicePrincipalSignInActivities(ctx, query.GraphParams{Top: 1}) {
+		if item.Error != nil {
+			log.Info("unable to read service principal sign-in activity, listing service principals without it", "err", item.Error)
+			return false
+		}
+		break
+	}
+	return true
+}
@@ -24,6 +24,9 @@ import (
 
 	"github.com/bloodhoundad/azurehound/v2/client"
 	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/models"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"go.uber.org/mock/gomock"
 )
@@ -67,3 +70,106 @@ func TestListServicePrincipals(t *testing.T) {
 		t.Error("expected channel to close from an error result but it did not")
 	}
 }
+
+func TestListServicePrincipals_SignInActivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	config.ColSignInActivity.Set(true)
+	defer config.ColSignInActivity.Set(false)
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockChannel := make(chan client.AzureResult[azure.ServicePrincipal])
+	mockProbeChannel := make(chan client.AzureResult[azure.ServicePrincipalSignInActivity])
+	mockActivityChannel := make(chan client.AzureResult[azure.ServicePrincipalSignInActivity])
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureADServicePrincipalSignInActivities(gomock.Any(), query.GraphParams{Top: 1}).Return(mockProbeChannel).Times(1)
+	mockClient.EXPECT().ListAzureADServicePrincipalSignInActivities(gomock.Any(), query.GraphParams{}).Return(mockActivityChannel).Times(1)
+	mockClient.EXPECT().ListAzureADServicePrincipals(gomock.Any(), gomock.Any()).Return(mockChannel).Times(1)
+
+	go func() {
+		defer close(mockProbeChannel)
+		mockProbeChannel <- client.AzureResult[azure.ServicePrincipalSignInActivity]{
+			Ok: azure.ServicePrincipalSignInActivity{AppId: "foo"},
+		}
+	}()
+	go func() {
+		defer close(mockActivityChannel)
+		mockActivityChannel <- client.AzureResult[azure.ServicePrincipalSignInActivity]{
+			Ok: azure.ServicePrincipalSignInActivity{
+				AppId:              "foo",
+				LastSignInActivity: &azure.SignInActivity{LastSignInDateTime: "2026-01-01T00:00:00Z"},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockChannel)
+		mockChannel <- client.AzureResult[azure.ServicePrincipal]{
+			Ok: azure.ServicePrincipal{AppId: "foo"},
+		}
+		mockChannel <- client.AzureResult[azure.ServicePrincipal]{
+			Ok: azure.ServicePrincipal{AppId: "bar"},
+		}
+	}()
+
+	channel := listServicePrincipals(ctx, mockClient)
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.ServicePrincipal); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.ServicePrincipal{})
+	} else if data.SignInActivity == nil || data.SignInActivity.LastSignInActivity.LastSignInDateTime != "2026-01-01T00:00:00Z" {
+		t.Errorf("got %v, want the sign-in activity of foo", data.SignInActivity)
+	}
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.ServicePrincipal); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.ServicePrincipal{})
+	} else if data.SignInActivity != nil {
+		t.Errorf("got %v, want no sign-in activity for bar", data.SignInActivity)
+	}
+}
+
+func TestListServicePrincipals_SignInActivityUnreadable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	config.ColSignInActivity.Set(true)
+	defer config.ColSignInActivity.Set(false)
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockChannel := make(chan client.AzureResult[azure.ServicePrincipal])
+	mockProbeChannel := make(chan client.AzureResult[azure.ServicePrincipalSignInActivity])
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	// the report is not read when the probe fails
+	mockClient.EXPECT().ListAzureADServicePrincipalSignInActivities(gomock.Any(), query.GraphParams{Top: 1}).Return(mockProbeChannel).Times(1)
+	mockClient.EXPECT().ListAzureADServicePrincipals(gomock.Any(), gomock.Any()).Return(mockChannel).Times(1)
+
+	go func() {
+		defer close(mockProbeChannel)
+		mockProbeChannel <- client.AzureResult[azure.ServicePrincipalSignInActivity]{
+			Error: fmt.Errorf("Authentication_RequestFromNonPremiumTenantOrB2CTenant"),
+		}
+	}()
+	go func() {
+		defer close(mockChannel)
+		mockChannel <- client.AzureResult[azure.ServicePrincipal]{
+			Ok: azure.ServicePrincipal{AppId: "foo"},
+		}
+	}()
+
+	channel := listServicePrincipals(ctx, mockClient)
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.ServicePrincipal); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.ServicePrincipal{})
+	} else if data.SignInActivity != nil {
+		t.Errorf("got %v, want no sign-in activity", data.SignInActivity)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
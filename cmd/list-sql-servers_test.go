@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListSqlServers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		serverId                 = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Sql/servers/baz"
+		mockSubscriptionsChannel = make(chan interface{})
+		mockSqlServerChannel     = make(chan client.AzureResult[azure.SqlServer])
+		mockFirewallRuleChannel  = make(chan client.AzureResult[azure.SqlServerFirewallRule])
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureSqlServers(gomock.Any(), "foo", query.RMParams{Expand: "administrators/activedirectory"}).Return(mockSqlServerChannel).Times(1)
+	mockClient.EXPECT().ListAzureSqlServerFirewallRules(gomock.Any(), serverId, query.RMParams{}).Return(mockFirewallRuleChannel).Times(1)
+	channel := listSqlServers(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{Subscription: azure.Subscription{SubscriptionId: "foo"}},
+		}
+	}()
+	go func() {
+		defer close(mockSqlServerChannel)
+		mockSqlServerChannel <- client.AzureResult[azure.SqlServer]{
+			Ok: azure.SqlServer{
+				Entity: azure.Entity{Id: serverId},
+				Properties: azure.SqlServerProperties{
+					Administrators: azure.SqlServerExternalAdministrator{Login: "sql-admins", PrincipalType: "Group"},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(mockFirewallRuleChannel)
+		mockFirewallRuleChannel <- client.AzureResult[azure.SqlServerFirewallRule]{
+			Ok: azure.SqlServerFirewallRule{Name: "AllowAllWindowsAzureIps"},
+		}
+		mockFirewallRuleChannel <- client.AzureResult[azure.SqlServerFirewallRule]{
+			Error: fmt.Errorf("I'm an error"),
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.SqlServer); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.SqlServer{})
+	} else if len(data.FirewallRules) != 1 || data.Properties.Administrators.Login != "sql-admins" || data.ResourceGroupId != "/subscriptions/foo/resourceGroups/bar" {
+		t.Errorf("got %v, want the entra admin, resource group and 1 firewall rule", data)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listStorageAccountBlobDataRolesCmd)
+}
+
+var listStorageAccountBlobDataRolesCmd = &cobra.Command{
+	Use:          "storage-account-blob-data-roles",
+	Long:         "Lists Azure Storage Account Storage Blob Data Owners, Contributors and Readers",
+	Run:          listStorageAccountBlobDataRolesCmdImpl,
+	SilenceUsage: true,
+}
+
+func listStorageAccountBlobDataRolesCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure storage account blob data roles...")
+	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
+	subscriptions := listSubscriptions(ctx, azClient)
+	storageAccounts := listStorageAccounts(ctx, azClient, subscriptions)
+	storageAccountRoleAssignments := listStorageAccountRoleAssignments(ctx, azClient, storageAccounts)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	stream := listStorageAccountBlobDataRoles(ctx, storageAccountRoleAssignments, roleDefinitions)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// storageBlobDataRoleIDs are ordered from most to least privileged so that each assignment is reported once, as the
+// strongest of the built-in roles it is equivalent to
+var storageBlobDataRoleIDs = []string{
+	constants.StorageBlobDataOwnerRoleID,
+	constants.StorageBlobDataContributorRoleID,
+	constants.StorageBlobDataReaderRoleID,
+}
+
+func listStorageAccountBlobDataRoles(
+	ctx context.Context,
+	storageAccountRoleAssignments <-chan azureWrapper[models.AzureRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
+) <-chan any {
+	return pipeline.Map(ctx.Done(), storageAccountRoleAssignments, func(ra azureWrapper[models.AzureRoleAssignments]) any {
+		blobDataRoles := models.StorageAccountBlobDataRoles{
+			StorageAccountId: ra.Data.ObjectId,
+		}
+
+		for _, roleAssignment := range ra.Data.RoleAssignments {
+			for _, roleId := range storageBlobDataRoleIDs {
+				if azureRoleAssignmentFilter(roleDefinitions, roleId)(roleAssignment) {
+					blobDataRoles.BlobDataRoles = append(blobDataRoles.BlobDataRoles, models.StorageAccountBlobDataRole{
+						RoleAssignment:   roleAssignment.Assignee,
+						BuiltInRoleId:    roleId,
+						StorageAccountId: roleAssignment.ObjectId,
+					})
+					break
+				}
+			}
+		}
+
+		return NewAzureWrapper(enums.KindAZStorageAccountBlobDataRole, blobDataRoles)
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListStorageAccountBlobDataRoles(t *testing.T) {
+	ctx := context.Background()
+
+	mockRoleAssignmentsChannel := make(chan azureWrapper[models.AzureRoleAssignments])
+	channel := listStorageAccountBlobDataRoles(ctx, mockRoleAssignmentsChannel, nil)
+
+	roleAssignment := func(roleDefinitionId string) models.AzureRoleAssignment {
+		return models.AzureRoleAssignment{
+			Assignee: azure.RoleAssignment{
+				Properties: azure.RoleAssignmentPropertiesWithScope{
+					RoleDefinitionId: "/providers/Microsoft.Authorization/roleDefinitions/" + roleDefinitionId,
+				},
+			},
+			ObjectId: "foo",
+		}
+	}
+
+	go func() {
+		defer close(mockRoleAssignmentsChannel)
+
+		mockRoleAssignmentsChannel <- NewAzureWrapper(
+			enums.KindAZStorageAccountRoleAssignment,
+			models.AzureRoleAssignments{
+				ObjectId: "foo",
+				RoleAssignments: []models.AzureRoleAssignment{
+					roleAssignment(constants.StorageBlobDataReaderRoleID),
+					roleAssignment(constants.OwnerRoleID),
+					roleAssignment(constants.StorageBlobDataOwnerRoleID),
+				},
+			},
+		)
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(azureWrapper[models.StorageAccountBlobDataRoles]); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, azureWrapper[models.StorageAccountBlobDataRoles]{})
+	} else if data := wrapper.Data; len(data.BlobDataRoles) != 2 {
+		t.Errorf("got %d blob data roles, want 2", len(data.BlobDataRoles))
+	} else if data.BlobDataRoles[0].BuiltInRoleId != constants.StorageBlobDataReaderRoleID || data.BlobDataRoles[1].BuiltInRoleId != constants.StorageBlobDataOwnerRoleID {
+		t.Errorf("got %v, want a reader and an owner", data.BlobDataRoles)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
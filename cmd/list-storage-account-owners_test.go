@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/constants"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListStorageAccountOwners(t *testing.T) {
+	ctx := context.Background()
+
+	mockRoleAssignmentsChannel := make(chan azureWrapper[models.AzureRoleAssignments])
+	channel := listStorageAccountOwners(ctx, mockRoleAssignmentsChannel, nil)
+
+	go func() {
+		defer close(mockRoleAssignmentsChannel)
+
+		mockRoleAssignmentsChannel <- NewAzureWrapper(
+			enums.KindAZStorageAccountRoleAssignment,
+			models.AzureRoleAssignments{
+				ObjectId: "foo",
+				RoleAssignments: []models.AzureRoleAssignment{
+					{
+						Assignee: azure.RoleAssignment{
+							Properties: azure.RoleAssignmentPropertiesWithScope{
+								RoleDefinitionId: constants.OwnerRoleID,
+							},
+						},
+						ObjectId: "foo",
+					},
+					{
+						Assignee: azure.RoleAssignment{
+							Properties: azure.RoleAssignmentPropertiesWithScope{
+								RoleDefinitionId: constants.ContributorRoleID,
+							},
+						},
+						ObjectId: "foo",
+					},
+				},
+			},
+		)
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(azureWrapper[models.StorageAccountOwners]); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, azureWrapper[models.StorageAccountOwners]{})
+	} else if data := wrapper.Data; len(data.Owners) != 1 || data.StorageAccountId != "foo" {
+		t.Errorf("got %v, want a single owner of foo", data)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
@@ -62,9 +62,9 @@ func listStorageAccountRoleAssignmentsImpl(cmd *cobra.Command, args []string) {
 	log.Info("collection completed", "duration", duration.String())
 }
 
-func listStorageAccountRoleAssignments(ctx context.Context, client client.AzureClient, storageAccounts <-chan interface{}) <-chan interface{} {
+func listStorageAccountRoleAssignments(ctx context.Context, client client.AzureClient, storageAccounts <-chan interface{}) <-chan azureWrapper[models.AzureRoleAssignments] {
 	var (
-		out     = make(chan interface{})
+		out     = make(chan azureWrapper[models.AzureRoleAssignments])
 		ids     = make(chan string)
 		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
 		wg      sync.WaitGroup
@@ -115,10 +115,7 @@ func listStorageAccountRoleAssignments(ctx context.Context, client client.AzureC
 						storageAccountRoleAssignments.RoleAssignments = append(storageAccountRoleAssignments.RoleAssignments, storageAccountRoleAssignment)
 					}
 				}
-				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
-					Kind: enums.KindAZStorageAccountRoleAssignment,
-					Data: storageAccountRoleAssignments,
-				}); !ok {
+				if ok := pipeline.Send(ctx.Done(), out, NewAzureWrapper(enums.KindAZStorageAccountRoleAssignment, storageAccountRoleAssignments)); !ok {
 					return
 				}
 				log.V(1).Info("finished listing storage account role assignments", "storageAccountId", id, "count", count)
@@ -101,6 +101,10 @@ func listStorageAccounts(ctx context.Context, client client.AzureClient, subscri
 							ResourceGroupId:   item.Ok.ResourceGroupId(),
 							ResourceGroupName: item.Ok.ResourceGroupName(),
 							TenantId:          client.TenantInfo().TenantId,
+
+							PublicNetworkAccess: item.Ok.PublicNetworkAccessEnabled(),
+							SharedKeyAccess:     item.Ok.SharedKeyAccessEnabled(),
+							AnonymousBlobAccess: item.Ok.AnonymousBlobAccessAllowed(),
 						}
 						log.V(2).Info("found storage account", "storageAccount", storageAccount)
 						count++
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
-	"path"
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
@@ -53,16 +52,17 @@ func listSubscriptionOwnersCmdImpl(cmd *cobra.Command, args []string) {
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure subscription owners...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	subscriptions := listSubscriptions(ctx, azClient)
 	roleAssignments := listSubscriptionRoleAssignments(ctx, azClient, subscriptions)
-	stream := listSubscriptionOwners(ctx, azClient, roleAssignments)
+	stream := listSubscriptionOwners(ctx, azClient, roleAssignments, roleDefinitions)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
 }
 
-func listSubscriptionOwners(ctx context.Context, client client.AzureClient, roleAssignments <-chan interface{}) <-chan interface{} {
+func listSubscriptionOwners(ctx context.Context, client client.AzureClient, roleAssignments <-chan interface{}, roleDefinitions *roleDefinitionIndex) <-chan interface{} {
 	out := make(chan interface{})
 
 	go func() {
@@ -81,9 +81,7 @@ func listSubscriptionOwners(ctx context.Context, client client.AzureClient, role
 					count = 0
 				)
 				for _, item := range roleAssignments.RoleAssignments {
-					roleDefinitionId := path.Base(item.RoleAssignment.Properties.RoleDefinitionId)
-
-					if roleDefinitionId == constants.OwnerRoleID {
+					if roleDefinitions.grants(item.RoleAssignment.Properties.RoleDefinitionId, constants.OwnerRoleID) {
 						subscriptionOwner := models.SubscriptionOwner{
 							Owner:          item.RoleAssignment,
 							SubscriptionId: item.SubscriptionId,
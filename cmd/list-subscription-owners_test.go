@@ -42,7 +42,7 @@ func TestListSubscriptionOwners(t *testing.T) {
 	mockRoleAssignmentsChannel := make(chan interface{})
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listSubscriptionOwners(ctx, mockClient, mockRoleAssignmentsChannel)
+	channel := listSubscriptionOwners(ctx, mockClient, mockRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockRoleAssignmentsChannel)
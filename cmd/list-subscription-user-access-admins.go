@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
-	"path"
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
@@ -53,16 +52,17 @@ func listSubscriptionUserAccessAdminsCmdImpl(cmd *cobra.Command, args []string)
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure subscription user access admins...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	subscriptions := listSubscriptions(ctx, azClient)
 	roleAssignments := listSubscriptionRoleAssignments(ctx, azClient, subscriptions)
-	stream := listSubscriptionUserAccessAdmins(ctx, azClient, roleAssignments)
+	stream := listSubscriptionUserAccessAdmins(ctx, azClient, roleAssignments, roleDefinitions)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
 }
 
-func listSubscriptionUserAccessAdmins(ctx context.Context, client client.AzureClient, vmRoleAssignments <-chan interface{}) <-chan interface{} {
+func listSubscriptionUserAccessAdmins(ctx context.Context, client client.AzureClient, vmRoleAssignments <-chan interface{}, roleDefinitions *roleDefinitionIndex) <-chan interface{} {
 	out := make(chan interface{})
 
 	go func() {
@@ -81,9 +81,7 @@ func listSubscriptionUserAccessAdmins(ctx context.Context, client client.AzureCl
 					count = 0
 				)
 				for _, item := range roleAssignments.RoleAssignments {
-					roleDefinitionId := path.Base(item.RoleAssignment.Properties.RoleDefinitionId)
-
-					if roleDefinitionId == constants.UserAccessAdminRoleID {
+					if roleDefinitions.grants(item.RoleAssignment.Properties.RoleDefinitionId, constants.UserAccessAdminRoleID) {
 						subscriptionUserAccessAdmin := models.SubscriptionUserAccessAdmin{
 							UserAccessAdmin: item.RoleAssignment,
 							SubscriptionId:  item.SubscriptionId,
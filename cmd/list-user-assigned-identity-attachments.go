@@ -0,0 +1,189 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	listRootCmd.AddCommand(listUserAssignedIdentityAttachmentsCmd)
+}
+
+var listUserAssignedIdentityAttachmentsCmd = &cobra.Command{
+	Use:          "user-assigned-identity-attachments",
+	Long:         "Lists the User-Assigned Managed Identities attached to Azure resources",
+	Run:          listUserAssignedIdentityAttachmentsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listUserAssignedIdentityAttachmentsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure user-assigned managed identity attachments...")
+	start := time.Now()
+
+	var (
+		subscriptions   = make(chan interface{})
+		subscriptions2  = make(chan interface{})
+		subscriptions3  = make(chan interface{})
+		subscriptions4  = make(chan interface{})
+		subscriptions5  = make(chan interface{})
+		subscriptions6  = make(chan interface{})
+		subscriptions7  = make(chan interface{})
+		subscriptions8  = make(chan interface{})
+		subscriptions9  = make(chan interface{})
+		subscriptions10 = make(chan interface{})
+		subscriptions11 = make(chan interface{})
+		subscriptions12 = make(chan interface{})
+		subscriptions13 = make(chan interface{})
+	)
+	pipeline.Tee(ctx.Done(), listSubscriptions(ctx, azClient), subscriptions, subscriptions2, subscriptions3, subscriptions4, subscriptions5, subscriptions6, subscriptions7, subscriptions8, subscriptions9, subscriptions10, subscriptions11, subscriptions12, subscriptions13)
+
+	stream := listUserAssignedIdentityAttachments(ctx, azClient,
+		listAutomationAccounts(ctx, azClient, subscriptions),
+		listContainerRegistries(ctx, azClient, subscriptions2),
+		listFunctionApps(ctx, azClient, subscriptions3),
+		listLogicApps(ctx, azClient, subscriptions4),
+		listManagedClusters(ctx, azClient, subscriptions5),
+		listStorageAccounts(ctx, azClient, subscriptions6),
+		listVirtualMachines(ctx, azClient, subscriptions7),
+		listVMScaleSets(ctx, azClient, subscriptions8),
+		listWebApps(ctx, azClient, subscriptions9),
+		listCosmosDBAccounts(ctx, azClient, subscriptions10),
+		listDataFactories(ctx, azClient, subscriptions11),
+		listSqlServers(ctx, azClient, subscriptions12),
+		listSynapseWorkspaces(ctx, azClient, subscriptions13),
+	)
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listUserAssignedIdentityAttachments emits, for each resource with at least one user-assigned managed identity, the
+// identities attached to it. The resources may be any of the RM resources whose models embed azure.ManagedIdentity.
+func listUserAssignedIdentityAttachments(ctx context.Context, client client.AzureClient, resources ...<-chan interface{}) <-chan interface{} {
+	var (
+		out = make(chan interface{})
+		wg  sync.WaitGroup
+	)
+
+	wg.Add(len(resources))
+	for i := range resources {
+		stream := resources[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range pipeline.OrDone(ctx.Done(), stream) {
+				if wrapper, ok := result.(AzureWrapper); !ok {
+					log.Error(fmt.Errorf("failed type assertion"), "unable to enumerate user-assigned managed identity attachments for this item", "result", result)
+					continue
+				} else if resourceId, identity, ok := resourceManagedIdentity(wrapper.Data); !ok {
+					log.Error(fmt.Errorf("failed type assertion"), "unable to enumerate user-assigned managed identity attachments for this item", "result", result)
+					continue
+				} else if len(identity.UserAssignedIdentities) > 0 {
+					attachments := models.UserAssignedIdentityAttachments{
+						ResourceId:   resourceId,
+						ResourceKind: wrapper.Kind,
+						TenantId:     client.TenantInfo().TenantId,
+					}
+					for identityId, userAssignedIdentity := range identity.UserAssignedIdentities {
+						attachments.Identities = append(attachments.Identities, models.UserAssignedIdentityAttachment{
+							IdentityId:  identityId,
+							ClientId:    userAssignedIdentity.ClientId,
+							PrincipalId: userAssignedIdentity.PrincipalId,
+						})
+					}
+					sort.Slice(attachments.Identities, func(i, j int) bool {
+						return attachments.Identities[i].IdentityId < attachments.Identities[j].IdentityId
+					})
+
+					log.V(2).Info("found user-assigned managed identity attachments", "attachments", attachments)
+					if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+						Kind: enums.KindAZIdentityAttachment,
+						Data: attachments,
+					}); !ok {
+						return
+					}
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all user-assigned managed identity attachments")
+	}()
+
+	return out
+}
+
+// resourceManagedIdentity returns the id and managed identity of an RM resource model
+func resourceManagedIdentity(data interface{}) (string, azure.ManagedIdentity, bool) {
+	switch resource := data.(type) {
+	case models.AppServiceSlot:
+		return resource.Id, resource.Identity, true
+	case models.AutomationAccount:
+		return resource.Id, resource.Identity, true
+	case models.ContainerRegistry:
+		return resource.Id, resource.Identity, true
+	case models.CosmosDBAccount:
+		return resource.Id, resource.Identity, true
+	case models.DataFactory:
+		return resource.Id, resource.Identity, true
+	case models.FunctionApp:
+		return resource.Id, resource.Identity, true
+	case models.LogicApp:
+		return resource.Id, resource.Identity, true
+	case models.ManagedCluster:
+		return resource.Id, resource.Identity, true
+	case models.SqlServer:
+		return resource.Id, resource.Identity, true
+	case models.StorageAccount:
+		return resource.Id, resource.Identity, true
+	case models.SynapseWorkspace:
+		return resource.Id, resource.Identity, true
+	case models.VirtualMachine:
+		return resource.Id, resource.Identity, true
+	case models.VMScaleSet:
+		return resource.Id, resource.Identity, true
+	case models.WebApp:
+		return resource.Id, resource.Identity, true
+	default:
+		return "", azure.ManagedIdentity{}, false
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListUserAssignedIdentityAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockVirtualMachinesChannel = make(chan interface{})
+		mockWebAppsChannel         = make(chan interface{})
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	channel := listUserAssignedIdentityAttachments(ctx, mockClient, mockVirtualMachinesChannel, mockWebAppsChannel)
+
+	go func() {
+		defer close(mockVirtualMachinesChannel)
+		defer close(mockWebAppsChannel)
+
+		// unexpected items are skipped without abandoning the stream
+		mockVirtualMachinesChannel <- "unexpected"
+		mockVirtualMachinesChannel <- AzureWrapper{
+			Kind: enums.KindAZVM,
+			Data: models.VirtualMachine{
+				VirtualMachine: azure.VirtualMachine{
+					Entity: azure.Entity{Id: "vm"},
+					Identity: azure.ManagedIdentity{
+						UserAssignedIdentities: map[string]azure.UserAssignedIdentity{
+							"uai2": {PrincipalId: "principal2"},
+							"uai1": {PrincipalId: "principal1"},
+						},
+					},
+				},
+			},
+		}
+		mockWebAppsChannel <- AzureWrapper{
+			Kind: enums.KindAZWebApp,
+			Data: models.WebApp{
+				WebApp: azure.WebApp{
+					Entity:   azure.Entity{Id: "webapp"},
+					Identity: azure.ManagedIdentity{Type: "SystemAssigned"},
+				},
+			},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.UserAssignedIdentityAttachments); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.UserAssignedIdentityAttachments{})
+	} else if data.ResourceId != "vm" || data.ResourceKind != enums.KindAZVM {
+		t.Errorf("got attachments for %s (%s), want vm (%s)", data.ResourceId, data.ResourceKind, enums.KindAZVM)
+	} else if len(data.Identities) != 2 || data.Identities[0].IdentityId != "uai1" || data.Identities[0].PrincipalId != "principal1" {
+		t.Errorf("got %v, want uai1 and uai2 sorted by id", data.Identities)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+	"github.com/spf13/cobra"
+)
+
+// Maps the per-user authentication method types to the method names used by the registration details
+// report so that both sources produce comparable output. Password methods are not reported as registered.
+var authenticationMethodTypes = map[string]string{
+	"#microsoft.graph.emailAuthenticationMethod":                   "email",
+	"#microsoft.graph.fido2AuthenticationMethod":                   "fido2SecurityKey",
+	"#microsoft.graph.microsoftAuthenticatorAuthenticationMethod":  "microsoftAuthenticatorPush",
+	"#microsoft.graph.phoneAuthenticationMethod":                   "mobilePhone",
+	"#microsoft.graph.platformCredentialAuthenticationMethod":      "macOsSecureEnclaveKey",
+	"#microsoft.graph.softwareOathAuthenticationMethod":            "softwareOneTimePasscode",
+	"#microsoft.graph.temporaryAccessPassAuthenticationMethod":     "temporaryAccessPass",
+	"#microsoft.graph.windowsHelloForBusinessAuthenticationMethod": "windowsHelloForBusiness",
+}
+
+func init() {
+	listRootCmd.AddCommand(listUserAuthenticationMethodsCmd)
+}
+
+var listUserAuthenticationMethodsCmd = &cobra.Command{
+	Use:          "user-authentication-methods",
+	Long:         "Lists Azure AD User Authentication Methods",
+	Run:          listUserAuthenticationMethodsCmdImpl,
+	SilenceUsage: true,
+}
+
+func listUserAuthenticationMethodsCmdImpl(cmd *cobra.Command, args []string) {
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, os.Kill)
+	defer gracefulShutdown(stop)
+
+	log.V(1).Info("testing connections")
+	azClient := connectAndCreateClient()
+	log.Info("collecting azure ad user authentication methods...")
+	start := time.Now()
+	stream := listUserAuthenticationMethods(ctx, azClient, listUsers(ctx, azClient))
+	panicrecovery.HandleBubbledPanic(ctx, stop, log)
+	outputStream(ctx, stream)
+	duration := time.Since(start)
+	log.Info("collection completed", "duration", duration.String())
+}
+
+// listUserAuthenticationMethods prefers the tenant-wide registration details report, which covers every user
+// in a handful of requests. The report requires a P1 or P2 license, so when it cannot be read the methods are
+// listed for each user in the users stream instead.
+func listUserAuthenticationMethods(ctx context.Context, client client.AzureClient, users <-chan interface{}) <-chan interface{} {
+	var (
+		out      = make(chan interface{})
+		decision = make(chan bool, 1)
+		pending  = bufferUsers(ctx, users, decision)
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		var (
+			count    = 0
+			decided  = false
+			fallback = false
+			decide   = func(value bool) {
+				if !decided {
+					decided, fallback = true, value
+					decision <- value
+				}
+			}
+		)
+		for item := range client.ListAzureADUserRegistrationDetails(ctx, query.GraphParams{}) {
+			if item.Error != nil && count == 0 {
+				log.Info("unable to read the user registration details report, listing authentication methods for each user instead", "err", item.Error)
+				decide(true)
+			} else if item.Error != nil {
+				log.Error(item.Error, "unable to continue processing user registration details")
+			} else {
+				decide(false)
+				log.V(2).Info("found user registration details", "details", item)
+				count++
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZUserAuthenticationMethods,
+					Data: models.UserAuthenticationMethods{
+						UserRegistrationDetails: item.Ok,
+						HasTemporaryAccessPass:  slices.Contains(item.Ok.MethodsRegistered, "temporaryAccessPass"),
+						TenantId:                client.TenantInfo().TenantId,
+						TenantName:              client.TenantInfo().DisplayName,
+					},
+				}); !ok {
+					return
+				}
+			}
+		}
+		decide(false)
+
+		if !fallback {
+			log.Info("finished listing all user registration details", "count", count)
+			return
+		}
+
+		for item := range listUserAuthenticationMethodsPerUser(ctx, client, pending) {
+			if ok := pipeline.SendAny(ctx.Done(), out, item); !ok {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// bufferUsers keeps reading users while the first page of the registration details report decides whether they are
+// needed, so the stream users is teed from is never held up by the report. Once decided the buffered and remaining
+// users are either passed on for the per-user fallback or discarded.
+func bufferUsers(ctx context.Context, users <-chan interface{}, decision <-chan bool) <-chan interface{} {
+	out := make(chan interface{})
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		var (
+			buffered []interface{}
+			stream   = pipeline.OrDone(ctx.Done(), users)
+			in       = stream
+			waiting  = true
+			fallback = false
+		)
+		for waiting {
+			select {
+			case user, ok := <-in:
+				if ok {
+					buffered = append(buffered, user)
+				} else {
+					// a nil channel is never ready, leaving only the decision to wait for
+					in = nil
+				}
+			case fallback = <-decision:
+				waiting = false
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if !fallback {
+			for range stream {
+			}
+			return
+		}
+
+		for _, user := range buffered {
+			if ok := pipeline.Send(ctx.Done(), out, user); !ok {
+				return
+			}
+		}
+		for user := range stream {
+			if ok := pipeline.Send(ctx.Done(), out, user); !ok {
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+func listUserAuthenticationMethodsPerUser(ctx context.Context, client client.AzureClient, users <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		ids     = make(chan models.User)
+		streams = pipeline.Demux(ctx.Done(), ids, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+		params  = query.GraphParams{}
+	)
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(ids)
+
+		for result := range pipeline.OrDone(ctx.Done(), users) {
+			if user, ok := result.(AzureWrapper).Data.(models.User); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to enumerate authentication methods for this item", "result", result)
+				continue
+			} else {
+				if ok := pipeline.Send(ctx.Done(), ids, user); !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for user := range stream {
+				var (
+					methods = models.UserAuthenticationMethods{
+						UserRegistrationDetails: azure.UserRegistrationDetails{
+							Entity:            azure.Entity{Id: user.Id},
+							UserPrincipalName: user.UserPrincipalName,
+							UserDisplayName:   user.DisplayName,
+							UserType:          user.UserType,
+						},
+						TenantId:   client.TenantInfo().TenantId,
+						TenantName: client.TenantInfo().DisplayName,
+					}
+					count = 0
+				)
+				for item := range client.ListAzureADUserAuthenticationMethods(ctx, user.Id, params) {
+					if item.Error != nil {
+						log.Error(item.Error, "unable to continue processing authentication methods for this user", "userId", user.Id)
+					} else {
+						log.V(2).Info("found user authentication method", "method", item)
+						count++
+						methods.Methods = append(methods.Methods, item.Ok)
+					}
+				}
+				summarizeAuthenticationMethods(&methods)
+				if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+					Kind: enums.KindAZUserAuthenticationMethods,
+					Data: methods,
+				}); !ok {
+					return
+				}
+				log.V(1).Info("finished listing user authentication methods", "userId", user.Id, "count", count)
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+		log.Info("finished listing all user authentication methods")
+	}()
+
+	return out
+}
+
+// summarizeAuthenticationMethods derives the report's registration flags from the per-user methods. The default
+// method is not available from the per-user endpoint and is left empty.
+func summarizeAuthenticationMethods(methods *models.UserAuthenticationMethods) {
+	for _, method := range methods.Methods {
+		name, ok := authenticationMethodTypes[method.ODataType]
+		if !ok {
+			continue
+		} else if !slices.Contains(methods.MethodsRegistered, name) {
+			methods.MethodsRegistered = append(methods.MethodsRegistered, name)
+		}
+
+		switch name {
+		case "email":
+			// email may only be used for self-service password reset
+		case "fido2SecurityKey", "macOsSecureEnclaveKey", "windowsHelloForBusiness":
+			methods.IsPasswordlessCapable = true
+			methods.IsMfaRegistered = true
+		case "temporaryAccessPass":
+			methods.HasTemporaryAccessPass = true
+		default:
+			methods.IsMfaRegistered = true
+		}
+	}
+	methods.IsMfaCapable = methods.IsMfaRegistered
+}
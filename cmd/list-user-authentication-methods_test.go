@@ -0,0 +1,147 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListUserAuthenticationMethods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockUsersChannel   = make(chan interface{})
+		mockReportChannel  = make(chan client.AzureResult[azure.UserRegistrationDetails])
+		mockMethodsChannel = make(chan client.AzureResult[azure.AuthenticationMethod])
+		mockError          = fmt.Errorf("I'm an error")
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureADUserRegistrationDetails(gomock.Any(), query.GraphParams{}).Return(mockReportChannel).Times(1)
+	mockClient.EXPECT().ListAzureADUserAuthenticationMethods(gomock.Any(), "foo", query.GraphParams{}).Return(mockMethodsChannel).Times(1)
+	channel := listUserAuthenticationMethods(ctx, mockClient, mockUsersChannel)
+
+	go func() {
+		defer close(mockUsersChannel)
+		mockUsersChannel <- AzureWrapper{
+			Data: models.User{User: azure.User{DirectoryObject: azure.DirectoryObject{Id: "foo"}, UserPrincipalName: "foo@contoso.com"}},
+		}
+	}()
+	go func() {
+		// the report is unlicensed so methods are listed per user
+		defer close(mockReportChannel)
+		mockReportChannel <- client.AzureResult[azure.UserRegistrationDetails]{Error: mockError}
+	}()
+	go func() {
+		defer close(mockMethodsChannel)
+		mockMethodsChannel <- client.AzureResult[azure.AuthenticationMethod]{
+			Ok: azure.AuthenticationMethod{ODataType: "#microsoft.graph.passwordAuthenticationMethod"},
+		}
+		mockMethodsChannel <- client.AzureResult[azure.AuthenticationMethod]{
+			Ok: azure.AuthenticationMethod{ODataType: "#microsoft.graph.temporaryAccessPassAuthenticationMethod", IsUsable: true},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if wrapper, ok := result.(AzureWrapper); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result, AzureWrapper{})
+	} else if data, ok := wrapper.Data.(models.UserAuthenticationMethods); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", wrapper.Data, models.UserAuthenticationMethods{})
+	} else if data.Id != "foo" || data.UserPrincipalName != "foo@contoso.com" || len(data.Methods) != 2 {
+		t.Errorf("got %v, want 2 methods for foo", data)
+	} else if !data.HasTemporaryAccessPass || data.IsMfaRegistered || len(data.MethodsRegistered) != 1 {
+		t.Errorf("got %v, want only a temporary access pass and no mfa", data)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
+
+func TestListUserAuthenticationMethods_Report(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		mockUsersChannel  = make(chan interface{})
+		mockReportChannel = make(chan client.AzureResult[azure.UserRegistrationDetails])
+		usersSent         = make(chan struct{})
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureADUserRegistrationDetails(gomock.Any(), query.GraphParams{}).Return(mockReportChannel).Times(1)
+	channel := listUserAuthenticationMethods(ctx, mockClient, mockUsersChannel)
+
+	go func() {
+		defer close(usersSent)
+		defer close(mockUsersChannel)
+		for _, id := range []string{"foo", "bar", "baz"} {
+			mockUsersChannel <- AzureWrapper{
+				Data: models.User{User: azure.User{DirectoryObject: azure.DirectoryObject{Id: id}}},
+			}
+		}
+	}()
+	go func() {
+		// the users must be read while the report is still being paged
+		defer close(mockReportChannel)
+		mockReportChannel <- client.AzureResult[azure.UserRegistrationDetails]{
+			Ok: azure.UserRegistrationDetails{Entity: azure.Entity{Id: "foo"}},
+		}
+		<-usersSent
+		mockReportChannel <- client.AzureResult[azure.UserRegistrationDetails]{
+			Ok: azure.UserRegistrationDetails{Entity: azure.Entity{Id: "bar"}, MethodsRegistered: []string{"temporaryAccessPass"}},
+		}
+	}()
+
+	for _, want := range []string{"foo", "bar"} {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if data, ok := result.(AzureWrapper).Data.(models.UserAuthenticationMethods); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.UserAuthenticationMethods{})
+		} else if data.Id != want {
+			t.Errorf("got %v, want %v", data.Id, want)
+		} else if data.HasTemporaryAccessPass != (want == "bar") {
+			t.Errorf("got temporary access pass %v for %v", data.HasTemporaryAccessPass, want)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
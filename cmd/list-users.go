@@ -25,6 +25,7 @@ import (
 
 	"github.com/bloodhoundad/azurehound/v2/client"
 	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
 	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
@@ -78,6 +79,9 @@ func listUsers(ctx context.Context, client client.AzureClient) <-chan interface{
 	go func() {
 		defer panicrecovery.PanicRecovery()
 		defer close(out)
+		if config.ColSignInActivity.Value().(bool) && canReadUserSignInActivity(ctx, client) {
+			params.Select = append(params.Select, "signInActivity")
+		}
 		count := 0
 		for item := range client.ListAzureADUsers(ctx, params) {
 			if item.Error != nil {
@@ -104,3 +108,20 @@ func listUsers(ctx context.Context, client client.AzureClient) <-chan interface{
 
 	return out
 }
+
+// canReadUserSignInActivity probes a single user, since selecting signInActivity without the AuditLog.Read.All
+// permission or a P1 license fails the whole request and would otherwise fail user collection altogether.
+func canReadUserSignInActivity(ctx context.Context, client client.AzureClient) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	params := query.GraphParams{Select: []string{"id", "signInActivity"}, Top: 1}
+	for item := range client.ListAzureADUsers(ctx, params) {
+		if item.Error != nil {
+			log.Info("unable to read user sign-in activity, listing users without it", "err", item.Error)
+			return false
+		}
+		break
+	}
+	return true
+}
@@ -20,10 +20,14 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
 	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/config"
+	"github.com/bloodhoundad/azurehound/v2/models"
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 	"go.uber.org/mock/gomock"
 )
@@ -68,3 +72,49 @@ func TestListUsers(t *testing.T) {
 		t.Error("expected channel to close from an error result but it did not")
 	}
 }
+
+func TestListUsers_SignInActivityUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	config.ColSignInActivity.Set(true)
+	defer config.ColSignInActivity.Set(false)
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+	mockProbeChannel := make(chan client.AzureResult[azure.User])
+	mockChannel := make(chan client.AzureResult[azure.User])
+	mockError := fmt.Errorf("I'm an error")
+	withoutSignInActivity := gomock.Cond(func(params query.GraphParams) bool {
+		return !slices.Contains(params.Select, "signInActivity")
+	})
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureADUsers(gomock.Any(), query.GraphParams{Select: []string{"id", "signInActivity"}, Top: 1}).Return(mockProbeChannel).Times(1)
+	mockClient.EXPECT().ListAzureADUsers(gomock.Any(), withoutSignInActivity).Return(mockChannel).Times(1)
+
+	go func() {
+		defer close(mockProbeChannel)
+		mockProbeChannel <- client.AzureResult[azure.User]{
+			Error: mockError,
+		}
+	}()
+	go func() {
+		defer close(mockChannel)
+		mockChannel <- client.AzureResult[azure.User]{
+			Ok: azure.User{},
+		}
+	}()
+
+	channel := listUsers(ctx, mockClient)
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.User); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.User{})
+	} else if data.SignInActivity != nil {
+		t.Errorf("got %v, want no sign-in activity", data.SignInActivity)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("should not have recieved from channel")
+	}
+}
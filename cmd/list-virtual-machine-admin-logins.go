@@ -51,11 +51,12 @@ func listVirtualMachineAdminLoginsCmdImpl(cmd *cobra.Command, args []string) {
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure virtual machine admin logins...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	subscriptions := listSubscriptions(ctx, azClient)
 	vms := listVirtualMachines(ctx, azClient, subscriptions)
 	vmRoleAssignments := listVirtualMachineRoleAssignments(ctx, azClient, vms)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
-	stream := listVirtualMachineAdminLogins(ctx, vmRoleAssignments)
+	stream := listVirtualMachineAdminLogins(ctx, vmRoleAssignments, roleDefinitions)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
@@ -64,9 +65,10 @@ func listVirtualMachineAdminLoginsCmdImpl(cmd *cobra.Command, args []string) {
 func listVirtualMachineAdminLogins(
 	ctx context.Context,
 	roleAssignments <-chan azureWrapper[models.VirtualMachineRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
 ) <-chan any {
 	return pipeline.Map(ctx.Done(), roleAssignments, func(ra azureWrapper[models.VirtualMachineRoleAssignments]) any {
-		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, vmRoleAssignmentFilter(constants.VirtualMachineAdministratorLoginRoleID))
+		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, vmRoleAssignmentFilter(roleDefinitions, constants.VirtualMachineAdministratorLoginRoleID))
 		adminLogins := internal.Map(filteredAssignments, func(ra models.VirtualMachineRoleAssignment) models.VirtualMachineAdminLogin {
 			return models.VirtualMachineAdminLogin{
 				VirtualMachineId: ra.VirtualMachineId,
@@ -43,7 +43,7 @@ func TestListVirtualMachineAdminLogins(t *testing.T) {
 	mockVMRoleAssignmentsChannel := make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listVirtualMachineAdminLogins(ctx, mockVMRoleAssignmentsChannel)
+	channel := listVirtualMachineAdminLogins(ctx, mockVMRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockVMRoleAssignmentsChannel)
@@ -51,11 +51,12 @@ func listVirtualMachineAvereContributorsCmdImpl(cmd *cobra.Command, args []strin
 	azClient := connectAndCreateClient()
 	log.Info("collecting azure virtual machine averecontributors...")
 	start := time.Now()
+	roleDefinitions := newRoleDefinitionIndex(ctx, listAllRoleDefinitions(ctx, azClient))
 	subscriptions := listSubscriptions(ctx, azClient)
 	vms := listVirtualMachines(ctx, azClient, subscriptions)
 	vmRoleAssignments := listVirtualMachineRoleAssignments(ctx, azClient, vms)
 	panicrecovery.HandleBubbledPanic(ctx, stop, log)
-	stream := listVirtualMachineAvereContributors(ctx, vmRoleAssignments)
+	stream := listVirtualMachineAvereContributors(ctx, vmRoleAssignments, roleDefinitions)
 	outputStream(ctx, stream)
 	duration := time.Since(start)
 	log.Info("collection completed", "duration", duration.String())
@@ -64,9 +65,10 @@ func listVirtualMachineAvereContributorsCmdImpl(cmd *cobra.Command, args []strin
 func listVirtualMachineAvereContributors(
 	ctx context.Context,
 	roleAssignments <-chan azureWrapper[models.VirtualMachineRoleAssignments],
+	roleDefinitions *roleDefinitionIndex,
 ) <-chan any {
 	return pipeline.Map(ctx.Done(), roleAssignments, func(ra azureWrapper[models.VirtualMachineRoleAssignments]) any {
-		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, vmRoleAssignmentFilter(constants.AvereContributorRoleID))
+		filteredAssignments := internal.Filter(ra.Data.RoleAssignments, vmRoleAssignmentFilter(roleDefinitions, constants.AvereContributorRoleID))
 		avereContributors := internal.Map(filteredAssignments, func(ra models.VirtualMachineRoleAssignment) models.VirtualMachineAvereContributor {
 			return models.VirtualMachineAvereContributor{
 				VirtualMachineId: ra.VirtualMachineId,
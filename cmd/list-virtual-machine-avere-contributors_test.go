@@ -43,7 +43,7 @@ func TestListVirtualMachineAvereContributors(t *testing.T) {
 	mockVMRoleAssignmentsChannel := make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listVirtualMachineAvereContributors(ctx, mockVMRoleAssignmentsChannel)
+	channel := listVirtualMachineAvereContributors(ctx, mockVMRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockVMRoleAssignmentsChannel)
@@ -43,7 +43,7 @@ func TestListVirtualMachineContributors(t *testing.T) {
 	mockVMRoleAssignmentsChannel := make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listVirtualMachineContributors(ctx, mockVMRoleAssignmentsChannel)
+	channel := listVirtualMachineContributors(ctx, mockVMRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockVMRoleAssignmentsChannel)
@@ -43,7 +43,7 @@ func TestListVirtualMachineOwners(t *testing.T) {
 	mockVMRoleAssignmentsChannel := make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listVirtualMachineOwners(ctx, mockVMRoleAssignmentsChannel)
+	channel := listVirtualMachineOwners(ctx, mockVMRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockVMRoleAssignmentsChannel)
@@ -43,7 +43,7 @@ func TestListVirtualMachineUserAccessAdmins(t *testing.T) {
 	mockVMRoleAssignmentsChannel := make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listVirtualMachineUserAccessAdmins(ctx, mockVMRoleAssignmentsChannel)
+	channel := listVirtualMachineUserAccessAdmins(ctx, mockVMRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockVMRoleAssignmentsChannel)
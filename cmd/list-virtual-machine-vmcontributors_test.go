@@ -43,7 +43,7 @@ func TestListVirtualMachineVMContributors(t *testing.T) {
 	mockVMRoleAssignmentsChannel := make(chan azureWrapper[models.VirtualMachineRoleAssignments])
 	mockTenant := azure.Tenant{}
 	mockClient.EXPECT().TenantInfo().Return(mockTenant).AnyTimes()
-	channel := listVirtualMachineVMContributors(ctx, mockVMRoleAssignmentsChannel)
+	channel := listVirtualMachineVMContributors(ctx, mockVMRoleAssignmentsChannel, nil)
 
 	go func() {
 		defer close(mockVMRoleAssignmentsChannel)
@@ -22,10 +22,12 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
 	"sync"
 	"time"
 
 	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
 	"github.com/bloodhoundad/azurehound/v2/config"
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/models"
@@ -130,5 +132,80 @@ func listWebApps(ctx context.Context, client client.AzureClient, subscriptions <
 		log.Info("finished listing all web apps")
 	}()
 
+	return withBasicPublishingCredentialsPolicies(ctx, client, out)
+}
+
+// withBasicPublishingCredentialsPolicies sets whether basic authentication is allowed on the web apps, function apps
+// and deployment slots in sites. Each site needs its own lookup, so the lookups are spread across streams rather than
+// made while the sites are listed.
+func withBasicPublishingCredentialsPolicies(ctx context.Context, client client.AzureClient, sites <-chan interface{}) <-chan interface{} {
+	var (
+		out     = make(chan interface{})
+		streams = pipeline.Demux(ctx.Done(), sites, config.ColStreamCount.Value().(int))
+		wg      sync.WaitGroup
+	)
+
+	wg.Add(len(streams))
+	for i := range streams {
+		stream := streams[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range stream {
+				if wrapper, ok := result.(AzureWrapper); ok {
+					switch data := wrapper.Data.(type) {
+					case models.WebApp:
+						data.ScmBasicAuthEnabled, data.FtpBasicAuthEnabled = listBasicPublishingCredentialsPolicies(ctx, client, data.Id)
+						wrapper.Data = data
+					case models.FunctionApp:
+						data.ScmBasicAuthEnabled, data.FtpBasicAuthEnabled = listBasicPublishingCredentialsPolicies(ctx, client, data.Id)
+						wrapper.Data = data
+					case models.AppServiceSlot:
+						data.ScmBasicAuthEnabled, data.FtpBasicAuthEnabled = listBasicPublishingCredentialsPolicies(ctx, client, data.Id)
+						wrapper.Data = data
+					}
+					result = wrapper
+				}
+
+				if ok := pipeline.SendAny(ctx.Done(), out, result); !ok {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
 	return out
 }
+
+// listBasicPublishingCredentialsPolicies reports whether basic authentication with a site's publishing credentials is
+// allowed for its SCM and FTP endpoints. Sites allow both unless a policy says otherwise. Both are nil when the
+// policies could not be read.
+func listBasicPublishingCredentialsPolicies(ctx context.Context, client client.AzureClient, siteId string) (*bool, *bool) {
+	var (
+		scm, ftp = true, true
+		failed   = false
+	)
+	for item := range client.ListAzureBasicPublishingCredentialsPolicies(ctx, siteId, query.RMParams{}) {
+		if item.Error != nil {
+			log.Error(item.Error, "unable to continue processing basic publishing credentials policies for this site", "siteId", siteId)
+			failed = true
+		} else {
+			switch path.Base(item.Ok.Id) {
+			case "scm":
+				scm = item.Ok.Properties.Allow
+			case "ftp":
+				ftp = item.Ok.Properties.Allow
+			}
+		}
+	}
+
+	if failed {
+		return nil, nil
+	}
+	return &scm, &ftp
+}
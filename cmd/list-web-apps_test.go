@@ -0,0 +1,87 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestListWebApps_UnreadablePolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		webAppId = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Web/sites/web"
+
+		mockSubscriptionsChannel = make(chan interface{})
+		mockWebAppsChannel       = make(chan client.AzureResult[azure.WebApp])
+		mockPoliciesChannel      = make(chan client.AzureResult[azure.PublishingCredentialsPolicy])
+	)
+
+	mockClient.EXPECT().TenantInfo().Return(azure.Tenant{}).AnyTimes()
+	mockClient.EXPECT().ListAzureWebApps(gomock.Any(), "foo").Return(mockWebAppsChannel).Times(1)
+	mockClient.EXPECT().ListAzureBasicPublishingCredentialsPolicies(gomock.Any(), webAppId, query.RMParams{}).Return(mockPoliciesChannel).Times(1)
+	channel := listWebApps(ctx, mockClient, mockSubscriptionsChannel)
+
+	go func() {
+		defer close(mockSubscriptionsChannel)
+		mockSubscriptionsChannel <- AzureWrapper{
+			Data: models.Subscription{Subscription: azure.Subscription{SubscriptionId: "foo"}},
+		}
+	}()
+	go func() {
+		defer close(mockWebAppsChannel)
+		mockWebAppsChannel <- client.AzureResult[azure.WebApp]{
+			Ok: azure.WebApp{Entity: azure.Entity{Id: webAppId}, Kind: "app"},
+		}
+	}()
+	go func() {
+		defer close(mockPoliciesChannel)
+		mockPoliciesChannel <- client.AzureResult[azure.PublishingCredentialsPolicy]{
+			Error: fmt.Errorf("forbidden"),
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.WebApp); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.WebApp{})
+	} else if data.ScmBasicAuthEnabled != nil || data.FtpBasicAuthEnabled != nil {
+		t.Errorf("got scm %v and ftp %v, want both unknown", data.ScmBasicAuthEnabled, data.FtpBasicAuthEnabled)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
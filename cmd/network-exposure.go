@@ -0,0 +1,420 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+)
+
+// networkIndex resolves the public addresses, network interfaces, security groups and subnets that virtual machines
+// and scale sets are attached to so that their exposure to the internet can be computed.
+type networkIndex struct {
+	done  <-chan struct{}
+	ready chan struct{}
+
+	networkInterfaces     map[string]azure.NetworkInterface
+	networkSecurityGroups map[string]azure.NetworkSecurityGroup
+	publicIPAddresses     map[string]azure.PublicIPAddress
+	subnets               map[string]azure.Subnet
+}
+
+// newNetworkIndex drains each stream in the background. Lookups block until every stream has been drained.
+func newNetworkIndex(ctx context.Context, publicIPAddresses, networkInterfaces, networkSecurityGroups, virtualNetworks <-chan interface{}) *networkIndex {
+	var (
+		index = &networkIndex{
+			done:                  ctx.Done(),
+			ready:                 make(chan struct{}),
+			networkInterfaces:     make(map[string]azure.NetworkInterface),
+			networkSecurityGroups: make(map[string]azure.NetworkSecurityGroup),
+			publicIPAddresses:     make(map[string]azure.PublicIPAddress),
+			subnets:               make(map[string]azure.Subnet),
+		}
+		wg sync.WaitGroup
+	)
+
+	// Each stream is drained independently so that a slow collection never holds up the others
+	drain := func(stream <-chan interface{}, fn func(interface{}) bool) {
+		defer panicrecovery.PanicRecovery()
+		defer wg.Done()
+		for result := range pipeline.OrDone(ctx.Done(), stream) {
+			if wrapper, ok := result.(AzureWrapper); !ok || !fn(wrapper.Data) {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to index this network resource", "result", result)
+			}
+		}
+	}
+
+	wg.Add(4)
+	go drain(publicIPAddresses, func(data interface{}) bool {
+		if publicIPAddress, ok := data.(models.PublicIPAddress); !ok {
+			return false
+		} else {
+			index.publicIPAddresses[strings.ToLower(publicIPAddress.Id)] = publicIPAddress.PublicIPAddress
+			return true
+		}
+	})
+	go drain(networkInterfaces, func(data interface{}) bool {
+		if networkInterface, ok := data.(models.NetworkInterface); !ok {
+			return false
+		} else {
+			index.networkInterfaces[strings.ToLower(networkInterface.Id)] = networkInterface.NetworkInterface
+			return true
+		}
+	})
+	go drain(networkSecurityGroups, func(data interface{}) bool {
+		if networkSecurityGroup, ok := data.(models.NetworkSecurityGroup); !ok {
+			return false
+		} else {
+			index.networkSecurityGroups[strings.ToLower(networkSecurityGroup.Id)] = networkSecurityGroup.NetworkSecurityGroup
+			return true
+		}
+	})
+	go drain(virtualNetworks, func(data interface{}) bool {
+		if virtualNetwork, ok := data.(models.VirtualNetwork); !ok {
+			return false
+		} else {
+			for _, subnet := range virtualNetwork.Properties.Subnets {
+				index.subnets[strings.ToLower(subnet.Id)] = subnet
+			}
+			return true
+		}
+	})
+
+	go func() {
+		wg.Wait()
+		close(index.ready)
+	}()
+
+	return index
+}
+
+func (s *networkIndex) wait() bool {
+	select {
+	case <-s.ready:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+// virtualMachineExposure returns the ports reachable from the internet through the public addresses of the virtual
+// machine's network interfaces. Load balancers are not collected, so ports published only through the inbound NAT
+// rules or load balancing rules of a load balancer in front of the virtual machine are not included.
+func (s *networkIndex) virtualMachineExposure(virtualMachine azure.VirtualMachine) portSet {
+	var exposed portSet
+	for _, reference := range virtualMachine.Properties.NetworkProfile.NetworkInterfaces {
+		if networkInterface, ok := s.networkInterfaces[strings.ToLower(reference.Id)]; ok {
+			for _, ipConfiguration := range networkInterface.Properties.IPConfigurations {
+				if publicIPAddressId := ipConfiguration.Properties.PublicIPAddress.Id; publicIPAddressId != "" {
+					var (
+						publicIPAddress = s.publicIPAddresses[strings.ToLower(publicIPAddressId)]
+						target          = networkTarget{
+							privateIPAddress:          ipConfiguration.Properties.PrivateIPAddress,
+							applicationSecurityGroups: ipConfiguration.Properties.ApplicationSecurityGroups,
+						}
+					)
+					exposed.union(s.exposure(publicIPAddress.Sku.Name, target, networkInterface.Properties.NetworkSecurityGroup.Id, ipConfiguration.Properties.Subnet.Id))
+				}
+			}
+		}
+	}
+	return exposed
+}
+
+// vmScaleSetExposure returns the ports reachable from the internet through the public addresses each instance of the
+// scale set is given by its network profile. Ports published through the inbound NAT pools or load balancing rules of
+// the scale set's load balancers are not included, since load balancers are not collected.
+func (s *networkIndex) vmScaleSetExposure(vmScaleSet azure.VMScaleSet) portSet {
+	var exposed portSet
+	for _, configuration := range vmScaleSet.Properties.VirtualMachineProfile.NetworkProfile.NetworkInterfaceConfigurations {
+		for _, ipConfiguration := range configuration.Properties.IPConfigurations {
+			if publicIPAddress := ipConfiguration.Properties.PublicIPAddressConfiguration; publicIPAddress.Name != "" {
+				target := networkTarget{applicationSecurityGroups: ipConfiguration.Properties.ApplicationSecurityGroups}
+				exposed.union(s.exposure(publicIPAddress.Sku.Name, target, configuration.Properties.NetworkSecurityGroup.Id, ipConfiguration.Properties.Subnet.Id))
+			}
+		}
+	}
+	return exposed
+}
+
+// exposure returns the ports allowed from the internet to target by the security groups of its network interface and
+// subnet. Inbound traffic must be allowed by both when both are set. Without either, basic public addresses are open
+// and standard public addresses are closed. Security groups that were not collected are assumed not to restrict.
+func (s *networkIndex) exposure(sku enums.IPSku, target networkTarget, networkSecurityGroupId, subnetId string) portSet {
+	var (
+		exposed = allPorts()
+		applied = false
+	)
+
+	for _, id := range []string{networkSecurityGroupId, s.subnets[strings.ToLower(subnetId)].Properties.NetworkSecurityGroup.Id} {
+		if id == "" {
+			continue
+		}
+		applied = true
+		if networkSecurityGroup, ok := s.networkSecurityGroups[strings.ToLower(id)]; ok {
+			exposed.intersect(internetInboundPorts(networkSecurityGroup, target))
+		}
+	}
+
+	if !applied && !strings.EqualFold(string(sku), string(enums.IPSkuBasic)) {
+		return portSet{}
+	}
+	return exposed
+}
+
+// networkTarget is the destination a security rule must match to apply to an ip configuration. The private address is
+// unknown for scale set instances.
+type networkTarget struct {
+	privateIPAddress          string
+	applicationSecurityGroups []azure.SubResource
+}
+
+// internetInboundPorts evaluates the inbound rules of a security group in priority order and returns the TCP ports
+// allowed from any internet address to target. Ports no rule matches are denied, as by the DenyAllInBound default.
+func internetInboundPorts(networkSecurityGroup azure.NetworkSecurityGroup, target networkTarget) portSet {
+	var decided, allowed portSet
+
+	for _, rule := range networkSecurityGroup.EffectiveInboundRules() {
+		if !appliesToTcp(rule) || !fromInternet(rule) || !toTarget(rule, target) {
+			continue
+		}
+		allow := strings.EqualFold(rule.Properties.Access, "Allow")
+		for _, portRange := range rule.DestinationPortRanges() {
+			if from, to, ok := parsePortRange(portRange); ok {
+				for port := from; port <= to; port++ {
+					if !decided.contains(port) {
+						decided.add(port)
+						if allow {
+							allowed.add(port)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return allowed
+}
+
+func appliesToTcp(rule azure.SecurityRule) bool {
+	protocol := strings.ToLower(rule.Properties.Protocol)
+	return protocol == "*" || protocol == "tcp"
+}
+
+// fromInternet reports whether the rule matches traffic from any internet address. Rules scoped to specific addresses
+// or service tags other than Internet are not considered.
+func fromInternet(rule azure.SecurityRule) bool {
+	for _, prefix := range rule.SourceAddressPrefixes() {
+		switch strings.ToLower(prefix) {
+		case "*", "internet", "any", "0.0.0.0/0", "::/0":
+			return true
+		}
+	}
+	return false
+}
+
+func toTarget(rule azure.SecurityRule, target networkTarget) bool {
+	for _, group := range rule.Properties.DestinationApplicationSecurityGroups {
+		for _, targetGroup := range target.applicationSecurityGroups {
+			if strings.EqualFold(group.Id, targetGroup.Id) {
+				return true
+			}
+		}
+	}
+
+	for _, prefix := range rule.DestinationAddressPrefixes() {
+		switch strings.ToLower(prefix) {
+		case "*", "any", "virtualnetwork":
+			return true
+		}
+
+		if target.privateIPAddress == "" {
+			// without a known address any explicit prefix may match one of the instances
+			if _, _, err := net.ParseCIDR(prefix); err == nil || net.ParseIP(prefix) != nil {
+				return true
+			}
+		} else if address := net.ParseIP(target.privateIPAddress); address == nil {
+			continue
+		} else if _, network, err := net.ParseCIDR(prefix); err == nil && network.Contains(address) {
+			return true
+		} else if ip := net.ParseIP(prefix); ip != nil && ip.Equal(address) {
+			return true
+		}
+	}
+	return false
+}
+
+// parsePortRange parses a security rule port range such as 22, 8000-8080 or *
+func parsePortRange(portRange string) (int, int, bool) {
+	portRange = strings.TrimSpace(portRange)
+	if portRange == "*" {
+		return 0, maxPort, true
+	}
+
+	from, to, found := strings.Cut(portRange, "-")
+	if !found {
+		to = from
+	}
+
+	if start, err := strconv.Atoi(strings.TrimSpace(from)); err != nil || start < 0 || start > maxPort {
+		return 0, 0, false
+	} else if end, err := strconv.Atoi(strings.TrimSpace(to)); err != nil || end < start || end > maxPort {
+		return 0, 0, false
+	} else {
+		return start, end, true
+	}
+}
+
+const maxPort = 65535
+
+// portSet is a set of ports stored as a bitmap
+type portSet [(maxPort + 1) / 64]uint64
+
+func allPorts() portSet {
+	var set portSet
+	for i := range set {
+		set[i] = ^uint64(0)
+	}
+	return set
+}
+
+func (s *portSet) add(port int) {
+	s[port/64] |= 1 << (port % 64)
+}
+
+func (s *portSet) contains(port int) bool {
+	return s[port/64]&(1<<(port%64)) != 0
+}
+
+func (s *portSet) union(other portSet) {
+	for i := range s {
+		s[i] |= other[i]
+	}
+}
+
+func (s *portSet) intersect(other portSet) {
+	for i := range s {
+		s[i] &= other[i]
+	}
+}
+
+// ranges formats the set as security rules do, e.g. [22 3389 8000-8080], or [*] when every port is included
+func (s *portSet) ranges() []string {
+	var (
+		result []string
+		count  int
+	)
+
+	for i := range s {
+		count += bits.OnesCount64(s[i])
+	}
+	if count == maxPort+1 {
+		return []string{"*"}
+	}
+
+	for port := 0; port <= maxPort; port++ {
+		if !s.contains(port) {
+			continue
+		}
+		start := port
+		for port < maxPort && s.contains(port+1) {
+			port++
+		}
+		if start == port {
+			result = append(result, strconv.Itoa(start))
+		} else {
+			result = append(result, fmt.Sprintf("%d-%d", start, port))
+		}
+	}
+	return result
+}
+
+// listNetworkExposure lists the internet exposed ports of the virtual machines and scale sets in resources once the
+// network index is ready. The resources stream is read as fast as it is produced so that the other consumers of the
+// listing never wait on the network collections; only the exposure records wait.
+func listNetworkExposure(ctx context.Context, resources <-chan interface{}, index *networkIndex) <-chan interface{} {
+	out := make(chan interface{})
+
+	go func() {
+		defer panicrecovery.PanicRecovery()
+		defer close(out)
+
+		var pending []interface{}
+		for result := range pipeline.OrDone(ctx.Done(), resources) {
+			if wrapper, ok := result.(AzureWrapper); !ok {
+				log.Error(fmt.Errorf("failed type assertion"), "unable to list network exposure for this item", "result", result)
+			} else {
+				switch wrapper.Data.(type) {
+				case models.VirtualMachine, models.VMScaleSet:
+					pending = append(pending, wrapper.Data)
+				default:
+					log.Error(fmt.Errorf("failed virtual machine type assertion"), "unable to list network exposure for this item", "result", result)
+				}
+			}
+		}
+
+		if !index.wait() {
+			return
+		}
+
+		for _, item := range pending {
+			var (
+				exposed portSet
+				record  models.NetworkExposure
+			)
+			switch data := item.(type) {
+			case models.VirtualMachine:
+				exposed = index.virtualMachineExposure(data.VirtualMachine)
+				record = models.NetworkExposure{
+					ResourceId:      data.Id,
+					SubscriptionId:  data.SubscriptionId,
+					ResourceGroupId: data.ResourceGroupId,
+					TenantId:        data.TenantId,
+				}
+			case models.VMScaleSet:
+				exposed = index.vmScaleSetExposure(data.VMScaleSet)
+				record = models.NetworkExposure{
+					ResourceId:      data.Id,
+					SubscriptionId:  data.SubscriptionId,
+					ResourceGroupId: data.ResourceGroupId,
+					TenantId:        data.TenantId,
+				}
+			}
+			record.InternetExposedPorts = exposed.ranges()
+			if ok := pipeline.SendAny(ctx.Done(), out, AzureWrapper{
+				Kind: enums.KindAZNetworkExposure,
+				Data: record,
+			}); !ok {
+				return
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,278 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+func init() {
+	setupLogger()
+}
+
+func securityRule(access, source string, priority int, ports ...string) azure.SecurityRule {
+	return azure.SecurityRule{
+		Properties: azure.SecurityRuleProperties{
+			Access:                   access,
+			DestinationAddressPrefix: "*",
+			DestinationPortRanges:    ports,
+			Direction:                "Inbound",
+			Priority:                 priority,
+			Protocol:                 "Tcp",
+			SourceAddressPrefix:      source,
+		},
+	}
+}
+
+func TestInternetInboundPorts(t *testing.T) {
+	networkSecurityGroup := azure.NetworkSecurityGroup{
+		Properties: azure.NetworkSecurityGroupProperties{
+			SecurityRules: []azure.SecurityRule{
+				securityRule("Allow", "*", 300, "8000-8080"),
+				securityRule("Deny", "Internet", 100, "8080"),
+				securityRule("Allow", "Internet", 200, "22", "3389"),
+				securityRule("Allow", "10.0.0.0/8", 110, "445"),
+			},
+			DefaultSecurityRules: []azure.SecurityRule{
+				securityRule("Allow", "VirtualNetwork", 65000, "*"),
+				securityRule("Deny", "*", 65500, "*"),
+			},
+		},
+	}
+
+	ports := internetInboundPorts(networkSecurityGroup, networkTarget{privateIPAddress: "10.0.0.4"})
+	if got, want := ports.ranges(), []string{"22", "3389", "8000-8079"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// rules for other destinations do not apply
+	networkSecurityGroup.Properties.SecurityRules[2].Properties.DestinationAddressPrefix = "10.1.0.0/16"
+	ports = internetInboundPorts(networkSecurityGroup, networkTarget{privateIPAddress: "10.0.0.4"})
+	if got, want := ports.ranges(), []string{"8000-8079"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestListNetworkExposure(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		nicId       = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Network/networkInterfaces/vm-nic"
+		nsgId       = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Network/networkSecurityGroups/vm-nsg"
+		subnetId    = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Network/virtualNetworks/vnet/subnets/default"
+		subnetNsgId = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Network/networkSecurityGroups/subnet-nsg"
+		publicIPId  = "/subscriptions/foo/resourceGroups/bar/providers/Microsoft.Network/publicIPAddresses/vm-ip"
+
+		publicIPAddresses     = make(chan interface{})
+		networkInterfaces     = make(chan interface{})
+		networkSecurityGroups = make(chan interface{})
+		virtualNetworks       = make(chan interface{})
+		resources             = make(chan interface{})
+	)
+
+	index := newNetworkIndex(ctx, publicIPAddresses, networkInterfaces, networkSecurityGroups, virtualNetworks)
+	channel := listNetworkExposure(ctx, resources, index)
+
+	go func() {
+		defer close(publicIPAddresses)
+		// unexpected items are skipped without abandoning the stream
+		publicIPAddresses <- AzureWrapper{Data: models.Subscription{}}
+		publicIPAddresses <- AzureWrapper{
+			Data: models.PublicIPAddress{
+				PublicIPAddress: azure.PublicIPAddress{
+					Entity: azure.Entity{Id: publicIPId},
+					Sku:    azure.VMPublicIPSku{Name: enums.IPSkuStandard},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(networkInterfaces)
+		networkInterfaces <- AzureWrapper{
+			Data: models.NetworkInterface{
+				NetworkInterface: azure.NetworkInterface{
+					Entity: azure.Entity{Id: nicId},
+					Properties: azure.NetworkInterfaceProperties{
+						NetworkSecurityGroup: azure.SubResource{Id: nsgId},
+						IPConfigurations: []azure.NetworkInterfaceIPConfiguration{{
+							Properties: azure.NetworkInterfaceIPConfigurationProperties{
+								PrivateIPAddress: "10.0.0.4",
+								PublicIPAddress:  azure.SubResource{Id: publicIPId},
+								Subnet:           azure.SubResource{Id: subnetId},
+							},
+						}},
+					},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(networkSecurityGroups)
+		networkSecurityGroups <- AzureWrapper{
+			Data: models.NetworkSecurityGroup{
+				NetworkSecurityGroup: azure.NetworkSecurityGroup{
+					Entity: azure.Entity{Id: nsgId},
+					Properties: azure.NetworkSecurityGroupProperties{
+						SecurityRules: []azure.SecurityRule{securityRule("Allow", "*", 100, "22", "3389")},
+					},
+				},
+			},
+		}
+		networkSecurityGroups <- AzureWrapper{
+			Data: models.NetworkSecurityGroup{
+				NetworkSecurityGroup: azure.NetworkSecurityGroup{
+					Entity: azure.Entity{Id: subnetNsgId},
+					Properties: azure.NetworkSecurityGroupProperties{
+						SecurityRules: []azure.SecurityRule{securityRule("Allow", "Internet", 100, "3389")},
+					},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(virtualNetworks)
+		virtualNetworks <- AzureWrapper{
+			Data: models.VirtualNetwork{
+				VirtualNetwork: azure.VirtualNetwork{
+					Properties: azure.VirtualNetworkProperties{
+						Subnets: []azure.Subnet{{
+							Id: subnetId,
+							Properties: azure.SubnetProperties{
+								NetworkSecurityGroup: azure.SubResource{Id: subnetNsgId},
+							},
+						}},
+					},
+				},
+			},
+		}
+	}()
+	go func() {
+		defer close(resources)
+		resources <- "unexpected"
+		resources <- AzureWrapper{Data: models.Subscription{}}
+		resources <- AzureWrapper{
+			Kind: enums.KindAZVM,
+			Data: models.VirtualMachine{
+				VirtualMachine: azure.VirtualMachine{
+					Entity: azure.Entity{Id: "vm"},
+					Properties: azure.VirtualMachineProperties{
+						NetworkProfile: azure.NetworkProfile{
+							NetworkInterfaces: []azure.NetworkInterfaceReference{{Id: nicId}},
+						},
+					},
+				},
+			},
+		}
+		// basic public addresses without a security group are open on every port
+		resources <- AzureWrapper{
+			Kind: enums.KindAZVMScaleSet,
+			Data: models.VMScaleSet{
+				VMScaleSet: azure.VMScaleSet{
+					Entity: azure.Entity{Id: "vm-scale-set"},
+					Properties: azure.VMScaleSetProperties{
+						VirtualMachineProfile: azure.VMScaleSetVMProfile{
+							NetworkProfile: azure.NetworkProfile{
+								NetworkInterfaceConfigurations: []azure.VirtualMachineNetworkInterfaceConfiguration{{
+									Properties: azure.VMNetworkInterfaceConfigurationProperties{
+										IPConfigurations: []azure.VMNetworkInterfaceIPConfig{{
+											Properties: azure.VMIPConfigProperties{
+												PublicIPAddressConfiguration: azure.VMPublicIPConfig{
+													Name: "instance-ip",
+													Sku:  azure.VMPublicIPSku{Name: enums.IPSkuBasic},
+												},
+											},
+										}},
+									},
+								}},
+							},
+						},
+					},
+				},
+			},
+		}
+	}()
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.NetworkExposure); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.NetworkExposure{})
+	} else if data.ResourceId != "vm" {
+		t.Errorf("got %v, want vm", data.ResourceId)
+	} else if got, want := data.InternetExposedPorts, []string{"3389"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if result, ok := <-channel; !ok {
+		t.Fatalf("failed to receive from channel")
+	} else if data, ok := result.(AzureWrapper).Data.(models.NetworkExposure); !ok {
+		t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.NetworkExposure{})
+	} else if data.ResourceId != "vm-scale-set" {
+		t.Errorf("got %v, want vm-scale-set", data.ResourceId)
+	} else if got, want := data.InternetExposedPorts, []string{"*"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
+
+func TestListNetworkExposure_ReadsAhead(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		publicIPAddresses     = make(chan interface{})
+		networkInterfaces     = make(chan interface{})
+		networkSecurityGroups = make(chan interface{})
+		virtualNetworks       = make(chan interface{})
+		resources             = make(chan interface{})
+	)
+
+	index := newNetworkIndex(ctx, publicIPAddresses, networkInterfaces, networkSecurityGroups, virtualNetworks)
+	channel := listNetworkExposure(ctx, resources, index)
+
+	// the resources are read while the network collections are still in progress
+	resources <- AzureWrapper{Data: models.VirtualMachine{VirtualMachine: azure.VirtualMachine{Entity: azure.Entity{Id: "vm"}}}}
+	resources <- AzureWrapper{Data: models.VirtualMachine{VirtualMachine: azure.VirtualMachine{Entity: azure.Entity{Id: "vm2"}}}}
+	close(resources)
+
+	close(publicIPAddresses)
+	close(networkInterfaces)
+	close(networkSecurityGroups)
+	close(virtualNetworks)
+
+	for _, want := range []string{"vm", "vm2"} {
+		if result, ok := <-channel; !ok {
+			t.Fatalf("failed to receive from channel")
+		} else if data, ok := result.(AzureWrapper).Data.(models.NetworkExposure); !ok {
+			t.Errorf("failed type assertion: got %T, want %T", result.(AzureWrapper).Data, models.NetworkExposure{})
+		} else if data.ResourceId != want || len(data.InternetExposedPorts) != 0 {
+			t.Errorf("got %v, want %v without exposed ports", data, want)
+		}
+	}
+
+	if _, ok := <-channel; ok {
+		t.Error("expected channel to close")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"github.com/bloodhoundad/azurehound/v2/pipeline"
+)
+
+// roleAssignmentIndex serves ListRoleAssignmentsForResource from role assignments listed once per subscription and
+// management group, rather than calling Azure Resource Manager once per resource. Results follow the semantics of the
+// per-resource call: assignments at, above and below the resource, or only at and above it when filtered by atScope().
+type roleAssignmentIndex struct {
+	client.AzureClient
+
+	mu     sync.Mutex
+	scopes map[string]*indexedRoleAssignments
+}
+
+type indexedRoleAssignments struct {
+	once            sync.Once
+	roleAssignments []azure.RoleAssignment
+	err             error
+}
+
+func newRoleAssignmentIndex(azClient client.AzureClient) client.AzureClient {
+	return &roleAssignmentIndex{
+		AzureClient: azClient,
+		scopes:      make(map[string]*indexedRoleAssignments),
+	}
+}
+
+func (s *roleAssignmentIndex) ListRoleAssignmentsForResource(ctx context.Context, resourceId string, filter, tenantId string) <-chan client.AzureResult[azure.RoleAssignment] {
+	var (
+		resource = strings.TrimSuffix(strings.ToLower(resourceId), "/")
+		scope    string
+		params   query.RMParams
+	)
+
+	if tenantId != "" || (filter != "" && filter != "atScope()") {
+		return s.AzureClient.ListRoleAssignmentsForResource(ctx, resourceId, filter, tenantId)
+	} else if subscriptionId, ok := strings.CutPrefix(resource, "/subscriptions/"); ok {
+		scope = "/subscriptions/" + strings.Split(subscriptionId, "/")[0]
+	} else if strings.HasPrefix(resource, "/providers/microsoft.management/managementgroups/") && filter == "atScope()" {
+		// Management groups are only indexed at and above their own scope
+		scope = resource
+		params.Filter = filter
+	} else {
+		return s.AzureClient.ListRoleAssignmentsForResource(ctx, resourceId, filter, tenantId)
+	}
+
+	if roleAssignments, err := s.load(ctx, scope, params); err != nil {
+		log.Error(err, "unable to index role assignments for this scope, falling back to per resource listing", "scope", scope)
+		return s.AzureClient.ListRoleAssignmentsForResource(ctx, resourceId, filter, tenantId)
+	} else {
+		out := make(chan client.AzureResult[azure.RoleAssignment])
+		go func() {
+			defer close(out)
+			for _, roleAssignment := range roleAssignments {
+				var (
+					assignmentScope = strings.TrimSuffix(strings.ToLower(roleAssignment.Properties.Scope), "/")
+					atOrAbove       = !isWithinScope(assignmentScope, scope) || isWithinScope(resource, assignmentScope)
+					below           = isWithinScope(assignmentScope, resource)
+				)
+				if atOrAbove || (below && filter == "") {
+					if ok := pipeline.Send(ctx.Done(), out, client.AzureResult[azure.RoleAssignment]{Ok: roleAssignment}); !ok {
+						return
+					}
+				}
+			}
+		}()
+		return out
+	}
+}
+
+// load lists the role assignments for scope the first time it is requested and returns the cached result thereafter
+func (s *roleAssignmentIndex) load(ctx context.Context, scope string, params query.RMParams) ([]azure.RoleAssignment, error) {
+	s.mu.Lock()
+	entry, ok := s.scopes[scope]
+	if !ok {
+		entry = &indexedRoleAssignments{}
+		s.scopes[scope] = entry
+	}
+	s.mu.Unlock()
+
+	entry.once.Do(func() {
+		for item := range s.AzureClient.ListAzureRoleAssignments(ctx, scope, params) {
+			if item.Error != nil {
+				entry.err = item.Error
+			} else {
+				entry.roleAssignments = append(entry.roleAssignments, item.Ok)
+			}
+		}
+		log.V(1).Info("finished indexing role assignments", "scope", scope, "count", len(entry.roleAssignments))
+	})
+
+	return entry.roleAssignments, entry.err
+}
+
+// isWithinScope reports whether the lower-cased scope equals or is nested beneath the lower-cased parent scope
+func isWithinScope(scope, parent string) bool {
+	return scope == parent || strings.HasPrefix(scope, strings.TrimSuffix(parent, "/")+"/")
+}
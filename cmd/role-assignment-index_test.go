@@ -0,0 +1,128 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/bloodhoundad/azurehound/v2/client"
+	"github.com/bloodhoundad/azurehound/v2/client/mocks"
+	"github.com/bloodhoundad/azurehound/v2/client/query"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	setupLogger()
+}
+
+func TestRoleAssignmentIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		subscriptionId  = "/subscriptions/00000000-0000-0000-0000-000000000000"
+		resourceGroupId = subscriptionId + "/resourceGroups/rg"
+		keyVaultId      = resourceGroupId + "/providers/Microsoft.KeyVault/vaults/kv"
+		scopes          = []string{
+			"/",
+			"/providers/Microsoft.Management/managementGroups/mg",
+			subscriptionId,
+			resourceGroupId,
+			keyVaultId,
+			subscriptionId + "/resourceGroups/rg2",
+		}
+		mockRoleAssignmentsChannel = make(chan client.AzureResult[azure.RoleAssignment])
+	)
+
+	mockClient.EXPECT().ListAzureRoleAssignments(gomock.Any(), subscriptionId, query.RMParams{}).Return(mockRoleAssignmentsChannel).Times(1)
+	index := newRoleAssignmentIndex(mockClient)
+
+	go func() {
+		defer close(mockRoleAssignmentsChannel)
+		for _, scope := range scopes {
+			mockRoleAssignmentsChannel <- client.AzureResult[azure.RoleAssignment]{
+				Ok: azure.RoleAssignment{
+					Properties: azure.RoleAssignmentPropertiesWithScope{Scope: scope},
+				},
+			}
+		}
+	}()
+
+	testCases := []struct {
+		resourceId string
+		filter     string
+		want       int
+	}{
+		{keyVaultId, "", 5},
+		{subscriptionId, "atScope()", 3},
+		{"/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourcegroups/RG", "", 5},
+	}
+
+	for _, testCase := range testCases {
+		count := 0
+		for range index.ListRoleAssignmentsForResource(ctx, testCase.resourceId, testCase.filter, "") {
+			count++
+		}
+		if count != testCase.want {
+			t.Errorf("ListRoleAssignmentsForResource(%s, %s): got %v, want %v", testCase.resourceId, testCase.filter, count, testCase.want)
+		}
+	}
+}
+
+func TestRoleAssignmentIndexFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	mockClient := mocks.NewMockAzureClient(ctrl)
+
+	var (
+		keyVaultId                 = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.KeyVault/vaults/kv"
+		mockRoleAssignmentsChannel = make(chan client.AzureResult[azure.RoleAssignment])
+		mockResourceChannel        = make(chan client.AzureResult[azure.RoleAssignment])
+	)
+
+	mockClient.EXPECT().ListAzureRoleAssignments(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockRoleAssignmentsChannel).Times(1)
+	mockClient.EXPECT().ListRoleAssignmentsForResource(gomock.Any(), keyVaultId, "", "").Return(mockResourceChannel).Times(1)
+	index := newRoleAssignmentIndex(mockClient)
+
+	go func() {
+		defer close(mockRoleAssignmentsChannel)
+		mockRoleAssignmentsChannel <- client.AzureResult[azure.RoleAssignment]{
+			Error: fmt.Errorf("I'm an error"),
+		}
+	}()
+	go func() {
+		defer close(mockResourceChannel)
+		mockResourceChannel <- client.AzureResult[azure.RoleAssignment]{}
+	}()
+
+	count := 0
+	for range index.ListRoleAssignmentsForResource(ctx, keyVaultId, "", "") {
+		count++
+	}
+	if count != 1 {
+		t.Errorf("got %v, want %v", count, 1)
+	}
+}
@@ -22,9 +22,9 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
-	"path"
 	"path/filepath"
 	"runtime/pprof"
+	"sync"
 
 	"github.com/bloodhoundad/azurehound/v2/client/rest"
 	"github.com/spf13/cobra"
@@ -36,6 +36,7 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/enums"
 	"github.com/bloodhoundad/azurehound/v2/logger"
 	"github.com/bloodhoundad/azurehound/v2/models"
+	"github.com/bloodhoundad/azurehound/v2/panicrecovery"
 	"github.com/bloodhoundad/azurehound/v2/pipeline"
 	"github.com/bloodhoundad/azurehound/v2/sinks"
 )
@@ -102,10 +103,11 @@ func testConnections() error {
 
 func newAzureClient() (client.AzureClient, error) {
 	var (
-		certFile   = config.AzCert.Value()
-		keyFile    = config.AzKey.Value()
-		clientCert string
-		clientKey  string
+		certFile            = config.AzCert.Value()
+		keyFile             = config.AzKey.Value()
+		bulkRoleAssignments = config.ColBulkRoleAssignments.Value().(bool)
+		clientCert          string
+		clientKey           string
 	)
 
 	if file, ok := certFile.(string); ok && file != "" {
@@ -144,7 +146,14 @@ func newAzureClient() (client.AzureClient, error) {
 		Username:        config.AzUsername.Value().(string),
 		ManagedIdentity: config.AzUseManagedIdentity.Value().(bool),
 	}
-	return client.NewClient(config)
+
+	if azClient, err := client.NewClient(config); err != nil {
+		return nil, err
+	} else if bulkRoleAssignments {
+		return newRoleAssignmentIndex(azClient), nil
+	} else {
+		return azClient, nil
+	}
 }
 
 func contains[T comparable](collection []T, value T) bool {
@@ -199,6 +208,47 @@ func setupLogger() {
 	}
 }
 
+// The maximum number of members or owners sent in a single record. Larger collections are split across
+// several records so that memory stays flat and no single record grows beyond what ingest accepts.
+const recordChunkSize = 1000
+
+// recordChunks accumulates the members or owners of a single entity and hands them to send every recordChunkSize
+// items. flush sends whatever remains, and sends an empty chunk when nothing was added at all so that entities without
+// members or owners are still recorded.
+type recordChunks[T any] struct {
+	send  func(items []T) bool
+	items []T
+	count int
+}
+
+func newRecordChunks[T any](send func(items []T) bool) *recordChunks[T] {
+	return &recordChunks[T]{send: send}
+}
+
+// add returns false when a full chunk could not be sent
+func (s *recordChunks[T]) add(item T) bool {
+	s.count++
+	s.items = append(s.items, item)
+	if len(s.items) < recordChunkSize {
+		return true
+	}
+
+	items := s.items
+	s.items = nil
+	return s.send(items)
+}
+
+// flush returns false when the remaining chunk could not be sent
+func (s *recordChunks[T]) flush() bool {
+	if len(s.items) == 0 && s.count > 0 {
+		return true
+	}
+
+	items := s.items
+	s.items = nil
+	return s.send(items)
+}
+
 // deprecated: use azureWrapper instead
 type AzureWrapper struct {
 	Kind enums.Kind  `json:"kind"`
@@ -217,6 +267,10 @@ func NewAzureWrapper[T any](kind enums.Kind, data T) azureWrapper[T] {
 	}
 }
 
+func (s azureWrapper[T]) data() interface{} {
+	return s.Data
+}
+
 func outputStream[T any](ctx context.Context, stream <-chan T) {
 	formatted := pipeline.FormatJson(ctx.Done(), stream)
 	if path := config.OutputFile.Value().(string); path != "" {
@@ -228,28 +282,81 @@ func outputStream[T any](ctx context.Context, stream <-chan T) {
 	}
 }
 
-func kvRoleAssignmentFilter(roleId string) func(models.KeyVaultRoleAssignment) bool {
+func kvRoleAssignmentFilter(roleDefinitions *roleDefinitionIndex, roleId string) func(models.KeyVaultRoleAssignment) bool {
 	return func(ra models.KeyVaultRoleAssignment) bool {
-		return path.Base(ra.RoleAssignment.Properties.RoleDefinitionId) == roleId
+		return roleDefinitions.grants(ra.RoleAssignment.Properties.RoleDefinitionId, roleId)
 	}
 }
 
-func vmRoleAssignmentFilter(roleId string) func(models.VirtualMachineRoleAssignment) bool {
+func vmRoleAssignmentFilter(roleDefinitions *roleDefinitionIndex, roleId string) func(models.VirtualMachineRoleAssignment) bool {
 	return func(ra models.VirtualMachineRoleAssignment) bool {
-		return path.Base(ra.RoleAssignment.Properties.RoleDefinitionId) == roleId
+		return roleDefinitions.grants(ra.RoleAssignment.Properties.RoleDefinitionId, roleId)
 	}
 }
 
-func rgRoleAssignmentFilter(roleId string) func(models.ResourceGroupRoleAssignment) bool {
+func rgRoleAssignmentFilter(roleDefinitions *roleDefinitionIndex, roleId string) func(models.ResourceGroupRoleAssignment) bool {
 	return func(ra models.ResourceGroupRoleAssignment) bool {
-		return path.Base(ra.RoleAssignment.Properties.RoleDefinitionId) == roleId
+		return roleDefinitions.grants(ra.RoleAssignment.Properties.RoleDefinitionId, roleId)
 	}
 }
 
-func mgmtGroupRoleAssignmentFilter(roleId string) func(models.ManagementGroupRoleAssignment) bool {
+func azureRoleAssignmentFilter(roleDefinitions *roleDefinitionIndex, roleId string) func(models.AzureRoleAssignment) bool {
+	return func(ra models.AzureRoleAssignment) bool {
+		return roleDefinitions.grants(ra.Assignee.Properties.RoleDefinitionId, roleId)
+	}
+}
+
+func mgmtGroupRoleAssignmentFilter(roleDefinitions *roleDefinitionIndex, roleId string) func(models.ManagementGroupRoleAssignment) bool {
 	return func(ra models.ManagementGroupRoleAssignment) bool {
-		return path.Base(ra.RoleAssignment.Properties.RoleDefinitionId) == roleId
+		return roleDefinitions.grants(ra.RoleAssignment.Properties.RoleDefinitionId, roleId)
+	}
+}
+
+// listRBACScopes merges streams of management groups, subscriptions and resource groups into a single stream of their
+// Azure RBAC scope ids.
+func listRBACScopes(ctx context.Context, sources ...<-chan interface{}) <-chan string {
+	var (
+		out = make(chan string)
+		wg  sync.WaitGroup
+	)
+
+	wg.Add(len(sources))
+	for i := range sources {
+		source := sources[i]
+		go func() {
+			defer panicrecovery.PanicRecovery()
+			defer wg.Done()
+			for result := range pipeline.OrDone(ctx.Done(), source) {
+				var scope string
+				if wrapper, ok := result.(AzureWrapper); !ok {
+					log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating rbac scopes", "result", result)
+					return
+				} else {
+					switch data := wrapper.Data.(type) {
+					case models.ManagementGroup:
+						scope = data.Id
+					case models.Subscription:
+						scope = data.Id
+					case models.ResourceGroup:
+						scope = data.Id
+					default:
+						log.Error(fmt.Errorf("failed type assertion"), "unable to continue enumerating rbac scopes", "result", result)
+						return
+					}
+				}
+				if ok := pipeline.Send(ctx.Done(), out, scope); !ok {
+					return
+				}
+			}
+		}()
 	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return out
 }
 
 func connectAndCreateClient() client.AzureClient {
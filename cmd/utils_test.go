@@ -0,0 +1,85 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cmd
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRecordChunks(t *testing.T) {
+	testCases := []struct {
+		name  string
+		items int
+		want  []int
+	}{
+		// entities without members or owners are still recorded, once
+		{"empty", 0, []int{0}},
+		{"partial", 3, []int{3}},
+		{"exact", recordChunkSize, []int{recordChunkSize}},
+		{"remainder", 2*recordChunkSize + 1, []int{recordChunkSize, recordChunkSize, 1}},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			sent   []int
+			chunks = newRecordChunks(func(items []int) bool {
+				sent = append(sent, len(items))
+				return true
+			})
+		)
+
+		for i := 0; i < testCase.items; i++ {
+			if !chunks.add(i) {
+				t.Fatalf("%s: add failed", testCase.name)
+			}
+		}
+		if !chunks.flush() {
+			t.Fatalf("%s: flush failed", testCase.name)
+		}
+
+		if !slices.Equal(sent, testCase.want) {
+			t.Errorf("%s: got chunks of %v, want %v", testCase.name, sent, testCase.want)
+		}
+		if chunks.count != testCase.items {
+			t.Errorf("%s: got count %v, want %v", testCase.name, chunks.count, testCase.items)
+		}
+	}
+}
+
+func TestRecordChunks_SendFailure(t *testing.T) {
+	chunks := newRecordChunks(func(items []int) bool {
+		return false
+	})
+
+	for i := 0; i < recordChunkSize-1; i++ {
+		if !chunks.add(i) {
+			t.Fatalf("add should not send before a chunk is full")
+		}
+	}
+	if chunks.add(recordChunkSize) {
+		t.Error("add should report a failed send of a full chunk")
+	}
+
+	empty := newRecordChunks(func(items []int) bool {
+		return false
+	})
+	if empty.flush() {
+		t.Error("flush should report a failed send of an empty chunk")
+	}
+}
@@ -326,6 +326,55 @@ var (
 		MaxValue:   50,
 	}
 
+	ColBulkRoleAssignments = Config{
+		Name:       "bulkRoleAssignments",
+		Shorthand:  "",
+		Usage:      "List Azure role assignments once per subscription and management group and serve resource role assignments from memory.",
+		Persistent: true,
+		Required:   false,
+		Default:    false,
+	}
+
+	ColKeyVaultContents = Config{
+		Name:       "keyVaultContents",
+		Shorthand:  "",
+		Usage:      "List the names and metadata of key vault secrets, keys and certificates. Requires list permissions on each vault's data plane.",
+		Persistent: true,
+		Required:   false,
+		Default:    false,
+	}
+
+	ColAuthMethods = Config{
+		Name:       "authMethods",
+		Shorthand:  "",
+		Usage:      "List the authentication methods each user has registered. Requires the AuditLog.Read.All or UserAuthenticationMethod.Read.All permission.",
+		Persistent: true,
+		Required:   false,
+		Default:    false,
+	}
+
+	// ColTransitiveMembers is costly in two ways. The privileged groups are only known once every other AD and RM
+	// record has been seen, so no transitive members are listed until the rest of the collection has finished. And
+	// for each privileged group with nested groups, the direct members of the group and of every nested group are
+	// listed again to work out membership depths, on top of the /transitiveMembers listing itself.
+	ColTransitiveMembers = Config{
+		Name:       "transitiveMembers",
+		Shorthand:  "",
+		Usage:      "List the flattened, transitive members of groups that hold directory roles, Azure roles or application ownership, and the transitive memberships of the users among them. Starts only after all other collection has finished.",
+		Persistent: true,
+		Required:   false,
+		Default:    false,
+	}
+
+	ColSignInActivity = Config{
+		Name:       "signInActivity",
+		Shorthand:  "",
+		Usage:      "Include the last sign-in activity of users and service principals. Requires the AuditLog.Read.All permission and a Microsoft Entra ID P1 or P2 license.",
+		Persistent: true,
+		Required:   false,
+		Default:    false,
+	}
+
 	// Command specific configurations
 	KeyVaultAccessTypes = Config{
 		Name:       "access-types",
@@ -384,6 +433,20 @@ var (
 		ColMaxConnsPerHost,
 		ColMaxIdleConnsPerHost,
 		ColStreamCount,
+		ColBulkRoleAssignments,
+		ColKeyVaultContents,
+		ColAuthMethods,
+		ColTransitiveMembers,
+		ColSignInActivity,
+	}
+
+	ListRootConfig = []Config{
+		OutputFile,
+		ColBulkRoleAssignments,
+		ColKeyVaultContents,
+		ColAuthMethods,
+		ColTransitiveMembers,
+		ColSignInActivity,
 	}
 )
 
@@ -27,12 +27,14 @@ const (
 	KindAZDeviceOwner                     Kind = "AZDeviceOwner"
 	KindAZGroup                           Kind = "AZGroup"
 	KindAZGroupMember                     Kind = "AZGroupMember"
+	KindAZGroupTransitiveMember           Kind = "AZGroupTransitiveMember"
 	KindAZGroupOwner                      Kind = "AZGroupOwner"
 	KindAZGroup365                        Kind = "AZGroup365"
 	KindAZGroup365Member                  Kind = "AZGroup365Member"
 	KindAZGroup365Owner                   Kind = "AZGroup365Owner"
 	KindAZKeyVault                        Kind = "AZKeyVault"
 	KindAZKeyVaultAccessPolicy            Kind = "AZKeyVaultAccessPolicy"
+	KindAZKeyVaultContents                Kind = "AZKeyVaultContents"
 	KindAZKeyVaultContributor             Kind = "AZKeyVaultContributor"
 	KindAZKeyVaultKVContributor           Kind = "AZKeyVaultKVContributor"
 	KindAZKeyVaultOwner                   Kind = "AZKeyVaultOwner"
@@ -57,6 +59,7 @@ const (
 	KindAZSubscriptionUserAccessAdmin     Kind = "AZSubscriptionUserAccessAdmin"
 	KindAZTenant                          Kind = "AZTenant"
 	KindAZUser                            Kind = "AZUser"
+	KindAZUserTransitiveMemberOf          Kind = "AZUserTransitiveMemberOf"
 	KindAZVM                              Kind = "AZVM"
 	KindAZVMAdminLogin                    Kind = "AZVMAdminLogin"
 	KindAZVMAvereContributor              Kind = "AZVMAvereContributor"
@@ -68,22 +71,72 @@ const (
 	KindAZAppRoleAssignment               Kind = "AZAppRoleAssignment"
 	KindAZStorageAccount                  Kind = "AZStorageAccount"
 	KindAZStorageAccountRoleAssignment    Kind = "AZStorageAccountRoleAssignment"
+	KindAZStorageAccountOwner             Kind = "AZStorageAccountOwner"
+	KindAZStorageAccountContributor       Kind = "AZStorageAccountContributor"
+	KindAZStorageAccountUserAccessAdmin   Kind = "AZStorageAccountUserAccessAdmin"
+	KindAZStorageAccountBlobDataRole      Kind = "AZStorageAccountBlobDataRole"
 	KindAZStorageContainer                Kind = "AZStorageContainer"
 	KindAZAutomationAccount               Kind = "AZAutomationAccount"
 	KindAZAutomationAccountRoleAssignment Kind = "AZAutomationAccountRoleAssignment"
+	KindAZAutomationRunbook               Kind = "AZAutomationRunbook"
+	KindAZAutomationHybridWorkerGroup     Kind = "AZAutomationHybridWorkerGroup"
+	KindAZAutomationAccountAssets         Kind = "AZAutomationAccountAssets"
 	KindAZLogicApp                        Kind = "AZLogicApp"
 	KindAZLogicAppRoleAssignment          Kind = "AZLogicAppRoleAssignment"
+	KindAZApiConnection                   Kind = "AZApiConnection"
+	KindAZLogicAppApiConnection           Kind = "AZLogicAppApiConnection"
 	KindAZFunctionApp                     Kind = "AZFunctionApp"
 	KindAZFunctionAppRoleAssignment       Kind = "AZFunctionAppRoleAssignment"
 	KindAZContainerRegistry               Kind = "AZContainerRegistry"
 	KindAZContainerRegistryRoleAssignment Kind = "AZContainerRegistryRoleAssignment"
 	KindAZWebApp                          Kind = "AZWebApp"
 	KindAZWebAppRoleAssignment            Kind = "AZWebAppRoleAssignment"
+	KindAZAppServiceSlot                  Kind = "AZAppServiceSlot"
+	KindAZAppServiceSlotRoleAssignment    Kind = "AZAppServiceSlotRoleAssignment"
 	KindAZManagedCluster                  Kind = "AZManagedCluster"
 	KindAZManagedClusterRoleAssignment    Kind = "AZManagedClusterRoleAssignment"
+	KindAZManagedClusterIdentity          Kind = "AZManagedClusterIdentity"
+	KindAZManagedClusterAdmin             Kind = "AZManagedClusterAdmin"
 	KindAZVMScaleSet                      Kind = "AZVMScaleSet"
 	KindAZVMScaleSetRoleAssignment        Kind = "AZVMScaleSetRoleAssignment"
+	KindAZPublicIPAddress                 Kind = "AZPublicIPAddress"
+	KindAZNetworkInterface                Kind = "AZNetworkInterface"
+	KindAZNetworkSecurityGroup            Kind = "AZNetworkSecurityGroup"
+	KindAZVirtualNetwork                  Kind = "AZVirtualNetwork"
+	KindAZNetworkExposure                 Kind = "AZNetworkExposure"
+	KindAZSqlServer                       Kind = "AZSqlServer"
+	KindAZSqlServerRoleAssignment         Kind = "AZSqlServerRoleAssignment"
+	KindAZCosmosDBAccount                 Kind = "AZCosmosDBAccount"
+	KindAZCosmosDBAccountRoleAssignment   Kind = "AZCosmosDBAccountRoleAssignment"
+	KindAZDataFactory                     Kind = "AZDataFactory"
+	KindAZDataFactoryRoleAssignment       Kind = "AZDataFactoryRoleAssignment"
+	KindAZSynapseWorkspace                Kind = "AZSynapseWorkspace"
+	KindAZSynapseWorkspaceRoleAssignment  Kind = "AZSynapseWorkspaceRoleAssignment"
+	KindAZLighthouseDelegation            Kind = "AZLighthouseDelegation"
 	KindAZRoleEligibilityScheduleInstance Kind = "AZRoleEligibilityScheduleInstance"
 	KindAZRoleManagementPolicyAssignment  Kind = "AZRoleManagementPolicyAssignment"
 	KindAZUserInteraction                 Kind = "AZUserInteraction"
+	KindAZConditionalAccessPolicy         Kind = "AZConditionalAccessPolicy"
+	KindAZNamedLocation                   Kind = "AZNamedLocation"
+	KindAZCrossTenantAccessConfiguration  Kind = "AZCrossTenantAccessConfiguration"
+	KindAZAuthorizationPolicy             Kind = "AZAuthorizationPolicy"
+	KindAZUserAuthenticationMethods       Kind = "AZUserAuthenticationMethods"
+	KindAZIntuneManagedDevice             Kind = "AZIntuneManagedDevice"
+	KindAZIntuneRoleAssignment            Kind = "AZIntuneRoleAssignment"
+	KindAZIntuneRoleScopeTag              Kind = "AZIntuneRoleScopeTag"
+	KindAZOAuth2PermissionGrant           Kind = "AZOAuth2PermissionGrant"
+	KindAZAdministrativeUnit              Kind = "AZAdministrativeUnit"
+	KindAZAdministrativeUnitMember        Kind = "AZAdministrativeUnitMember"
+	KindAZAdministrativeUnitScopedRole    Kind = "AZAdministrativeUnitScopedRole"
+	KindAZAppFederatedCredential          Kind = "AZAppFederatedIdentityCredential"
+	KindAZIdentityFederatedCredential     Kind = "AZManagedIdentityFederatedIdentityCredential"
+	KindAZGroupEligibilityInstance        Kind = "AZGroupEligibilityScheduleInstance"
+	KindAZGroupAssignmentInstance         Kind = "AZGroupAssignmentScheduleInstance"
+	KindAZResourceRoleEligibilityInstance Kind = "AZResourceRoleEligibilityScheduleInstance"
+	KindAZResourceRoleManagementPolicy    Kind = "AZResourceRoleManagementPolicyAssignment"
+	KindAZRoleDefinition                  Kind = "AZRoleDefinition"
+	KindAZPolicyAssignment                Kind = "AZPolicyAssignment"
+	KindAZPolicyDefinition                Kind = "AZPolicyDefinition"
+	KindAZUserAssignedIdentity            Kind = "AZUserAssignedIdentity"
+	KindAZIdentityAttachment              Kind = "AZUserAssignedIdentityAttachment"
 )
@@ -0,0 +1,67 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+type AdministrativeUnit struct {
+	azure.AdministrativeUnit
+	TenantId   string `json:"tenantId"`
+	TenantName string `json:"tenantName"`
+}
+
+type AdministrativeUnitMember struct {
+	Member               json.RawMessage `json:"member"`
+	AdministrativeUnitId string          `json:"administrativeUnitId"`
+}
+
+func (s *AdministrativeUnitMember) MarshalJSON() ([]byte, error) {
+	output := make(map[string]any)
+	output["administrativeUnitId"] = s.AdministrativeUnitId
+
+	if member, err := OmitEmpty(s.Member); err != nil {
+		return nil, err
+	} else {
+		output["member"] = member
+		return json.Marshal(output)
+	}
+}
+
+type AdministrativeUnitMembers struct {
+	Members                      []AdministrativeUnitMember `json:"members"`
+	AdministrativeUnitId         string                     `json:"administrativeUnitId"`
+	IsMemberManagementRestricted bool                       `json:"isMemberManagementRestricted"`
+}
+
+type AdministrativeUnitScopedRole struct {
+	azure.ScopedRoleMembership
+
+	// The unified role definition id (role template id) of the directory role held by the member
+	RoleDefinitionId string `json:"roleDefinitionId"`
+}
+
+type AdministrativeUnitScopedRoles struct {
+	ScopedRoles                  []AdministrativeUnitScopedRole `json:"scopedRoles"`
+	AdministrativeUnitId         string                         `json:"administrativeUnitId"`
+	IsMemberManagementRestricted bool                           `json:"isMemberManagementRestricted"`
+	TenantId                     string                         `json:"tenantId"`
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+type ApiConnection struct {
+	azure.ApiConnection
+	SubscriptionId    string `json:"subscriptionId"`
+	ResourceGroupId   string `json:"resourceGroupId"`
+	ResourceGroupName string `json:"resourceGroupName"`
+	TenantId          string `json:"tenantId"`
+
+	// The principal the connection authenticates as: a user principal name, a client id, or empty when the connection
+	// uses the calling Logic App's managed identity
+	AuthenticationType enums.ApiConnectionAuthenticationType `json:"authenticationType"`
+	AuthenticatedAs    string                                `json:"authenticatedAs"`
+}
+
+// LogicAppApiConnections relates a Logic App to the API connections its workflow calls
+type LogicAppApiConnections struct {
+	LogicAppId  string                         `json:"logicAppId"`
+	Connections []azure.ApiConnectionReference `json:"connections"`
+	TenantId    string                         `json:"tenantId"`
+}
@@ -0,0 +1,38 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
+
+// AppServiceSlot is a deployment slot of a web app or function app. Slots run their own code with their own managed
+// identities and role assignments.
+type AppServiceSlot struct {
+	azure.WebApp
+	SiteId            string     `json:"siteId"`
+	SiteKind          enums.Kind `json:"siteKind"`
+	SubscriptionId    string     `json:"subscriptionId"`
+	ResourceGroupId   string     `json:"resourceGroupId"`
+	ResourceGroupName string     `json:"resourceGroupName"`
+	TenantId          string     `json:"tenantId"`
+
+	ScmBasicAuthEnabled *bool `json:"scmBasicAuthEnabled,omitempty"`
+	FtpBasicAuthEnabled *bool `json:"ftpBasicAuthEnabled,omitempty"`
+}
@@ -29,3 +29,11 @@ type AzureRoleAssignments struct {
 	RoleAssignments []AzureRoleAssignment `json:"assignees"`
 	ObjectId        string                `json:"objectId"`
 }
+
+func (s AzureRoleAssignments) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.RoleAssignments))
+	for _, item := range s.RoleAssignments {
+		ids = append(ids, item.Assignee.Properties.PrincipalId)
+	}
+	return ids
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// An administrative unit provides a conceptual container for user, group, and device directory objects. Using
+// administrative units, a company administrator can now delegate administrative responsibilities to manage the users,
+// groups, and devices contained within or scoped to an administrative unit to a regional or departmental
+// administrator.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/administrativeunit?view=graph-rest-1.0
+type AdministrativeUnit struct {
+	DirectoryObject
+
+	// An optional description for the administrative unit.
+	// Supports $filter (eq, ne, in, startsWith), $search.
+	Description string `json:"description,omitempty"`
+
+	// Display name for the administrative unit.
+	// Supports $filter (eq, ne, not, ge, le, in, startsWith, and eq on null values), $search, and $orderby.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// true if members of this administrative unit should be treated as sensitive, which requires specific permissions
+	// to manage.
+	// If not set, the default value is null and the default behavior is false.
+	// Use this property to define administrative units with roles that don't inherit from tenant-level administrators,
+	// and management of individual member objects is limited to administrators scoped to a restricted management
+	// administrative unit.
+	IsMemberManagementRestricted bool `json:"isMemberManagementRestricted,omitempty"`
+
+	// Indicates the membership type for the administrative unit.
+	// The possible values are: dynamic, assigned. If not set, the default value is null and the default behavior is
+	// assigned.
+	MembershipType string `json:"membershipType,omitempty"`
+
+	// The dynamic membership rule for the administrative unit.
+	MembershipRule string `json:"membershipRule,omitempty"`
+
+	// Controls whether the administrative unit and its members are hidden or public.
+	// Can be set to HiddenMembership. If not set, the default behavior is public.
+	Visibility string `json:"visibility,omitempty"`
+}
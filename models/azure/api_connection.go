@@ -0,0 +1,115 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+)
+
+// ApiConnection is a Microsoft.Web/connections resource, the stored authentication a Logic App uses to call a managed
+// connector such as Office 365, Key Vault or SQL.
+type ApiConnection struct {
+	Entity
+
+	Kind       string                  `json:"kind,omitempty"`
+	Location   string                  `json:"location,omitempty"`
+	Name       string                  `json:"name,omitempty"`
+	Properties ApiConnectionProperties `json:"properties,omitempty"`
+	Tags       map[string]string       `json:"tags,omitempty"`
+	Type       string                  `json:"type,omitempty"`
+}
+
+type ApiConnectionProperties struct {
+	Api ApiConnectionApiReference `json:"api,omitempty"`
+
+	// The user an OAuth connection was consented as
+	AuthenticatedUser ApiConnectionAuthenticatedUser `json:"authenticatedUser,omitempty"`
+
+	ChangedTime string `json:"changedTime,omitempty"`
+	CreatedTime string `json:"createdTime,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Connection parameters the service does not treat as secret, e.g. the client id of a service principal
+	NonSecretParameterValues map[string]interface{} `json:"nonSecretParameterValues,omitempty"`
+
+	// The alternative parameter set the connection was created with, e.g. managedIdentityAuth
+	ParameterValueSet ApiConnectionParameterValueSet `json:"parameterValueSet,omitempty"`
+
+	ParameterValueType string                `json:"parameterValueType,omitempty"`
+	Statuses           []ApiConnectionStatus `json:"statuses,omitempty"`
+}
+
+type ApiConnectionApiReference struct {
+	DisplayName string `json:"displayName,omitempty"`
+	Id          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+type ApiConnectionAuthenticatedUser struct {
+	Name string `json:"name,omitempty"`
+}
+
+type ApiConnectionParameterValueSet struct {
+	Name string `json:"name,omitempty"`
+}
+
+type ApiConnectionStatus struct {
+	Status string `json:"status,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+// AuthenticatedPrincipal returns the kind of principal the connection authenticates as and, for users and service
+// principals, their user principal name or client id. Connections using a managed identity authenticate as the
+// identity of the Logic App calling them.
+func (s ApiConnection) AuthenticatedPrincipal() (enums.ApiConnectionAuthenticationType, string) {
+	if strings.EqualFold(s.Properties.ParameterValueSet.Name, "managedIdentityAuth") {
+		return enums.ApiConnectionManagedIdentity, ""
+	}
+
+	for key, value := range s.Properties.NonSecretParameterValues {
+		if clientId, ok := value.(string); ok && strings.EqualFold(key, "token:clientId") && clientId != "" {
+			return enums.ApiConnectionServicePrincipal, clientId
+		}
+	}
+
+	if s.Properties.AuthenticatedUser.Name != "" {
+		return enums.ApiConnectionUser, s.Properties.AuthenticatedUser.Name
+	}
+	return enums.ApiConnectionOther, ""
+}
+
+func (s ApiConnection) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s ApiConnection) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
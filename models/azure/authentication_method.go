@@ -0,0 +1,50 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents an authentication method registered to a user. The concrete method is identified by its
+// @odata.type; only the properties relevant to judging the method's strength are captured.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/authenticationmethod?view=graph-rest-1.0
+type AuthenticationMethod struct {
+	Entity
+
+	// The method type, e.g. #microsoft.graph.fido2AuthenticationMethod.
+	ODataType string `json:"@odata.type,omitempty"`
+
+	// The name of the device or key the method is bound to.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// The date and time the method was registered.
+	CreatedDateTime string `json:"createdDateTime,omitempty"`
+
+	// The type of phone registered, one of mobile, alternateMobile or office.
+	// Only applies to phoneAuthenticationMethod.
+	PhoneType string `json:"phoneType,omitempty"`
+
+	// Whether the temporary access pass can currently be used to sign in.
+	// Only applies to temporaryAccessPassAuthenticationMethod.
+	IsUsable bool `json:"isUsable,omitempty"`
+
+	// The date and time the temporary access pass becomes available to use.
+	// Only applies to temporaryAccessPassAuthenticationMethod.
+	StartDateTime string `json:"startDateTime,omitempty"`
+
+	// The lifetime of the temporary access pass in minutes, starting at StartDateTime.
+	// Only applies to temporaryAccessPassAuthenticationMethod.
+	LifetimeInMinutes int `json:"lifetimeInMinutes,omitempty"`
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents the tenant-wide authorization policy that controls what default users and guests can do,
+// who can invite guests and whether users can consent to applications.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/authorizationpolicy?view=graph-rest-1.0
+type AuthorizationPolicy struct {
+	Entity
+
+	// Display name for this policy.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description of this policy.
+	Description string `json:"description,omitempty"`
+
+	// Indicates who can invite guests to the organization.
+	// Possible values are none, adminsAndGuestInviters, adminsGuestInvitersAndAllMembers and everyone.
+	AllowInvitesFrom string `json:"allowInvitesFrom,omitempty"`
+
+	// Indicates whether users can sign up for email based subscriptions.
+	AllowedToSignUpEmailBasedSubscriptions bool `json:"allowedToSignUpEmailBasedSubscriptions"`
+
+	// Indicates whether users can use the Self-Service Password Reset feature on the tenant.
+	AllowedToUseSSPR bool `json:"allowedToUseSSPR"`
+
+	// Indicates whether a user can join the tenant by email validation.
+	AllowEmailVerifiedUsersToJoinOrganization bool `json:"allowEmailVerifiedUsersToJoinOrganization"`
+
+	// Indicates whether user consent for risky apps is allowed.
+	AllowUserConsentForRiskyApps bool `json:"allowUserConsentForRiskyApps"`
+
+	// Whether to block MSOL PowerShell.
+	BlockMsolPowerShell bool `json:"blockMsolPowerShell"`
+
+	// The role that should be granted to guest users.
+	// Refer to https://learn.microsoft.com/en-us/graph/api/resources/authorizationpolicy?view=graph-rest-1.0#properties
+	// for the well-known role ids that map to guest access levels.
+	GuestUserRoleId string `json:"guestUserRoleId,omitempty"`
+
+	// Specifies certain customizable permissions for default user role.
+	DefaultUserRolePermissions DefaultUserRolePermissions `json:"defaultUserRolePermissions"`
+}
+
+type DefaultUserRolePermissions struct {
+	// Indicates whether the default user role can create applications.
+	AllowedToCreateApps bool `json:"allowedToCreateApps"`
+
+	// Indicates whether the default user role can create security groups.
+	AllowedToCreateSecurityGroups bool `json:"allowedToCreateSecurityGroups"`
+
+	// Indicates whether the default user role can create tenants.
+	AllowedToCreateTenants bool `json:"allowedToCreateTenants"`
+
+	// Indicates whether the registered owners of a device can read their own BitLocker recovery keys.
+	AllowedToReadBitlockerKeysForOwnedDevice bool `json:"allowedToReadBitlockerKeysForOwnedDevice"`
+
+	// Indicates whether the default user role can read other users.
+	AllowedToReadOtherUsers bool `json:"allowedToReadOtherUsers"`
+
+	// Indicates if user consent to apps is allowed, and if it is, which permission grant policy governs it.
+	// An empty list means users cannot consent to apps.
+	PermissionGrantPoliciesAssigned []string `json:"permissionGrantPoliciesAssigned"`
+}
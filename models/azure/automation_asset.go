@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// AutomationAsset is the common shape of automation credentials, certificates, connections and variables. Only the
+// fields that identify an asset are mapped so that values, user names and thumbprints are never decoded.
+type AutomationAsset struct {
+	Entity
+
+	Name       string                    `json:"name,omitempty"`
+	Properties AutomationAssetProperties `json:"properties,omitempty"`
+	Type       string                    `json:"type,omitempty"`
+}
+
+type AutomationAssetProperties struct {
+	CreationTime     string `json:"creationTime,omitempty"`
+	Description      string `json:"description,omitempty"`
+	LastModifiedTime string `json:"lastModifiedTime,omitempty"`
+}
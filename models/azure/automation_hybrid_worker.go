@@ -0,0 +1,60 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/automation/hybrid-runbook-worker-group/get?view=rest-automation-2023-11-01#hybridrunbookworkergroup
+type AutomationHybridWorkerGroup struct {
+	Entity
+
+	Name       string                                `json:"name,omitempty"`
+	Properties AutomationHybridWorkerGroupProperties `json:"properties,omitempty"`
+	Type       string                                `json:"type,omitempty"`
+}
+
+type AutomationHybridWorkerGroupProperties struct {
+	// The name of the automation credential jobs in the group run as, if any
+	Credential AutomationRunAsCredential `json:"credential,omitempty"`
+
+	// Whether the group's workers are User or System hybrid workers
+	GroupType string `json:"groupType,omitempty"`
+}
+
+type AutomationRunAsCredential struct {
+	Name string `json:"name,omitempty"`
+}
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/automation/hybrid-runbook-workers/get?view=rest-automation-2023-11-01#hybridrunbookworker
+type AutomationHybridWorker struct {
+	Entity
+
+	Name       string                           `json:"name,omitempty"`
+	Properties AutomationHybridWorkerProperties `json:"properties,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type AutomationHybridWorkerProperties struct {
+	Ip                 string `json:"ip,omitempty"`
+	LastSeenDateTime   string `json:"lastSeenDateTime,omitempty"`
+	RegisteredDateTime string `json:"registeredDateTime,omitempty"`
+
+	// The Azure or Arc-enabled machine the worker runs on
+	VmResourceId string `json:"vmResourceId,omitempty"`
+
+	WorkerName string `json:"workerName,omitempty"`
+	WorkerType string `json:"workerType,omitempty"`
+}
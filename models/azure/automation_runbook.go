@@ -0,0 +1,43 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/automation/runbook/get?view=rest-automation-2023-11-01#runbook
+type AutomationRunbook struct {
+	Entity
+
+	Etag       string                      `json:"etag,omitempty"`
+	Location   string                      `json:"location,omitempty"`
+	Name       string                      `json:"name,omitempty"`
+	Properties AutomationRunbookProperties `json:"properties,omitempty"`
+	Tags       map[string]string           `json:"tags,omitempty"`
+	Type       string                      `json:"type,omitempty"`
+}
+
+type AutomationRunbookProperties struct {
+	CreationTime     string `json:"creationTime,omitempty"`
+	Description      string `json:"description,omitempty"`
+	LastModifiedBy   string `json:"lastModifiedBy,omitempty"`
+	LastModifiedTime string `json:"lastModifiedTime,omitempty"`
+
+	// The type of the runbook, e.g. PowerShell, Python3 or GraphPowerShellWorkflow
+	RunbookType string `json:"runbookType,omitempty"`
+
+	// The state of the runbook: New, Edit or Published
+	State string `json:"state,omitempty"`
+}
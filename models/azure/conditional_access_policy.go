@@ -0,0 +1,171 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "encoding/json"
+
+// Represents a Microsoft Entra Conditional Access policy.
+// Conditional access policies are custom rules that define an access scenario.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/conditionalaccesspolicy?view=graph-rest-1.0
+type ConditionalAccessPolicy struct {
+	Entity
+
+	// The date and time the policy was created.
+	CreatedDateTime string `json:"createdDateTime,omitempty"`
+
+	// The date and time the policy was last modified.
+	ModifiedDateTime string `json:"modifiedDateTime,omitempty"`
+
+	// Display name for the policy.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Specifies the state of the policy.
+	// Possible values are: enabled, disabled, enabledForReportingButNotEnforced.
+	State string `json:"state,omitempty"`
+
+	// Specifies the rules that must be met for the policy to apply.
+	Conditions ConditionalAccessConditionSet `json:"conditions,omitempty"`
+
+	// Specifies the grant controls that must be fulfilled to pass the policy.
+	GrantControls *ConditionalAccessGrantControls `json:"grantControls,omitempty"`
+
+	// Specifies the session controls that are enforced after sign-in.
+	SessionControls json.RawMessage `json:"sessionControls,omitempty"`
+}
+
+// Represents the type of conditions that govern when the policy applies.
+type ConditionalAccessConditionSet struct {
+	// Applications and user actions included in and excluded from the policy.
+	Applications ConditionalAccessApplications `json:"applications,omitempty"`
+
+	// Users, groups, and roles included in and excluded from the policy.
+	Users ConditionalAccessUsers `json:"users,omitempty"`
+
+	// Client applications (service principals and workload identities) included in and excluded from the policy.
+	ClientApplications *ConditionalAccessClientApplications `json:"clientApplications,omitempty"`
+
+	// Client application types included in the policy.
+	// Possible values are: all, browser, mobileAppsAndDesktopClients, exchangeActiveSync, easSupported, other.
+	ClientAppTypes []string `json:"clientAppTypes,omitempty"`
+
+	// Locations included in and excluded from the policy.
+	Locations *ConditionalAccessLocations `json:"locations,omitempty"`
+
+	// Platforms included in and excluded from the policy.
+	Platforms *ConditionalAccessPlatforms `json:"platforms,omitempty"`
+
+	// Sign-in risk levels included in the policy.
+	SignInRiskLevels []string `json:"signInRiskLevels,omitempty"`
+
+	// User risk levels included in the policy.
+	UserRiskLevels []string `json:"userRiskLevels,omitempty"`
+
+	// Service principal risk levels included in the policy.
+	ServicePrincipalRiskLevels []string `json:"servicePrincipalRiskLevels,omitempty"`
+}
+
+type ConditionalAccessApplications struct {
+	// Can be one of the following:
+	// - The list of client IDs (appId) the policy applies to
+	// - All
+	// - Office365
+	// - MicrosoftAdminPortals
+	IncludeApplications []string `json:"includeApplications,omitempty"`
+
+	// Can be one of the following:
+	// - The list of client IDs (appId) explicitly excluded from the policy.
+	// - Office365
+	// - MicrosoftAdminPortals
+	ExcludeApplications []string `json:"excludeApplications,omitempty"`
+
+	// User actions to include. Supported values are urn:user:registersecurityinfo and urn:user:registerdevice
+	IncludeUserActions []string `json:"includeUserActions,omitempty"`
+
+	// Authentication context class references include.
+	IncludeAuthenticationContextClassReferences []string `json:"includeAuthenticationContextClassReferences,omitempty"`
+}
+
+type ConditionalAccessUsers struct {
+	// User IDs in scope of policy unless explicitly excluded, None, All, or GuestsOrExternalUsers.
+	IncludeUsers []string `json:"includeUsers,omitempty"`
+
+	// User IDs excluded from scope of policy and/or GuestsOrExternalUsers.
+	ExcludeUsers []string `json:"excludeUsers,omitempty"`
+
+	// Group IDs in scope of policy unless explicitly excluded.
+	IncludeGroups []string `json:"includeGroups,omitempty"`
+
+	// Group IDs excluded from scope of policy.
+	ExcludeGroups []string `json:"excludeGroups,omitempty"`
+
+	// Role template IDs in scope of policy unless explicitly excluded.
+	IncludeRoles []string `json:"includeRoles,omitempty"`
+
+	// Role template IDs excluded from scope of policy.
+	ExcludeRoles []string `json:"excludeRoles,omitempty"`
+
+	// Internal guests or external users in the policy scope.
+	IncludeGuestsOrExternalUsers json.RawMessage `json:"includeGuestsOrExternalUsers,omitempty"`
+
+	// Internal guests or external users excluded from the policy scope.
+	ExcludeGuestsOrExternalUsers json.RawMessage `json:"excludeGuestsOrExternalUsers,omitempty"`
+}
+
+type ConditionalAccessClientApplications struct {
+	// Service principal IDs included in the policy scope, or ServicePrincipalsInMyTenant.
+	IncludeServicePrincipals []string `json:"includeServicePrincipals,omitempty"`
+
+	// Service principal IDs excluded from the policy scope.
+	ExcludeServicePrincipals []string `json:"excludeServicePrincipals,omitempty"`
+}
+
+type ConditionalAccessLocations struct {
+	// Location IDs in scope of policy unless explicitly excluded, All, or AllTrusted.
+	IncludeLocations []string `json:"includeLocations,omitempty"`
+
+	// Location IDs excluded from scope of policy, or AllTrusted.
+	ExcludeLocations []string `json:"excludeLocations,omitempty"`
+}
+
+type ConditionalAccessPlatforms struct {
+	// Possible values are: android, iOS, windows, windowsPhone, macOS, linux, all.
+	IncludePlatforms []string `json:"includePlatforms,omitempty"`
+
+	// Possible values are: android, iOS, windows, windowsPhone, macOS, linux, all.
+	ExcludePlatforms []string `json:"excludePlatforms,omitempty"`
+}
+
+// Represents grant controls that must be fulfilled to pass the policy.
+type ConditionalAccessGrantControls struct {
+	// Defines the relationship of the grant controls. Possible values: AND, OR.
+	Operator string `json:"operator,omitempty"`
+
+	// List of values of built-in controls required by the policy.
+	// Possible values: block, mfa, compliantDevice, domainJoinedDevice, approvedApplication, compliantApplication,
+	// passwordChange.
+	BuiltInControls []string `json:"builtInControls,omitempty"`
+
+	// List of custom controls IDs required by the policy.
+	CustomAuthenticationFactors []string `json:"customAuthenticationFactors,omitempty"`
+
+	// List of terms of use IDs required by the policy.
+	TermsOfUse []string `json:"termsOfUse,omitempty"`
+
+	// The authentication strength required by the policy.
+	AuthenticationStrength json.RawMessage `json:"authenticationStrength,omitempty"`
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// CosmosDBAccount https://learn.microsoft.com/en-us/rest/api/cosmos-db-resource-provider/database-accounts/list?view=rest-cosmos-db-resource-provider-2024-05-15#databaseaccountgetresults
+type CosmosDBAccount struct {
+	Entity
+
+	Identity   ManagedIdentity           `json:"identity,omitempty"`
+	Kind       string                    `json:"kind,omitempty"`
+	Location   string                    `json:"location,omitempty"`
+	Name       string                    `json:"name,omitempty"`
+	Properties CosmosDBAccountProperties `json:"properties,omitempty"`
+	Tags       map[string]string         `json:"tags,omitempty"`
+	Type       string                    `json:"type,omitempty"`
+}
+
+type CosmosDBAccountProperties struct {
+	// Whether account keys may be used to change databases and containers through the resource provider
+	DisableKeyBasedMetadataWriteAccess bool `json:"disableKeyBasedMetadataWriteAccess,omitempty"`
+
+	// Whether account keys and resource tokens are rejected so that only Entra principals can access data
+	DisableLocalAuth bool `json:"disableLocalAuth,omitempty"`
+
+	DocumentEndpoint              string              `json:"documentEndpoint,omitempty"`
+	IpRules                       []CosmosDBIpAddress `json:"ipRules,omitempty"`
+	IsVirtualNetworkFilterEnabled bool                `json:"isVirtualNetworkFilterEnabled,omitempty"`
+	ProvisioningState             string              `json:"provisioningState,omitempty"`
+	PublicNetworkAccess           string              `json:"publicNetworkAccess,omitempty"`
+}
+
+type CosmosDBIpAddress struct {
+	IpAddressOrRange string `json:"ipAddressOrRange,omitempty"`
+}
+
+// LocalAuthEnabled reports whether the account's keys grant access to its data
+func (s CosmosDBAccount) LocalAuthEnabled() bool {
+	return !s.Properties.DisableLocalAuth
+}
+
+func (s CosmosDBAccount) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s CosmosDBAccount) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
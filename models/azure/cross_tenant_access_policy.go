@@ -0,0 +1,124 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents the cross-tenant access settings that apply either to all external tenants (the default
+// configuration) or to a single partner tenant.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/crosstenantaccesspolicyconfigurationdefault?view=graph-rest-1.0
+// and https://learn.microsoft.com/en-us/graph/api/resources/crosstenantaccesspolicyconfigurationpartner?view=graph-rest-1.0
+type CrossTenantAccessPolicyConfiguration struct {
+	// The tenant identifier for the partner organization.
+	// Only applies to partner configurations.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// If true, the default configuration is set to the system default configuration.
+	// Only applies to the default configuration.
+	IsServiceDefault bool `json:"isServiceDefault,omitempty"`
+
+	// Identifies whether the partner-specific configuration is a Cloud Service Provider for your organization.
+	// Only applies to partner configurations.
+	IsServiceProvider bool `json:"isServiceProvider,omitempty"`
+
+	// Identifies whether a tenant is a member of a multitenant organization.
+	// Only applies to partner configurations.
+	IsInMultiTenantOrganization bool `json:"isInMultiTenantOrganization,omitempty"`
+
+	// Determines whether MFA and device claims from the external tenant are trusted.
+	// A nil value on a partner configuration means the default configuration is inherited.
+	InboundTrust *CrossTenantAccessPolicyInboundTrust `json:"inboundTrust,omitempty"`
+
+	// Settings for users from other organizations accessing this tenant's resources via B2B collaboration.
+	B2BCollaborationInbound *CrossTenantAccessPolicyB2BSetting `json:"b2bCollaborationInbound,omitempty"`
+
+	// Settings for users in this tenant accessing resources in other organizations via B2B collaboration.
+	B2BCollaborationOutbound *CrossTenantAccessPolicyB2BSetting `json:"b2bCollaborationOutbound,omitempty"`
+
+	// Settings for users from other organizations accessing this tenant's resources via B2B direct connect.
+	B2BDirectConnectInbound *CrossTenantAccessPolicyB2BSetting `json:"b2bDirectConnectInbound,omitempty"`
+
+	// Settings for users in this tenant accessing resources in other organizations via B2B direct connect.
+	B2BDirectConnectOutbound *CrossTenantAccessPolicyB2BSetting `json:"b2bDirectConnectOutbound,omitempty"`
+
+	// Determines whether the consent prompt is suppressed when users are redeemed into or out of the tenant.
+	AutomaticUserConsentSettings *InboundOutboundPolicyConfiguration `json:"automaticUserConsentSettings,omitempty"`
+}
+
+type CrossTenantAccessPolicyInboundTrust struct {
+	// Whether MFA performed in the external tenant is accepted.
+	IsMfaAccepted bool `json:"isMfaAccepted"`
+
+	// Whether compliant device claims from the external tenant are accepted.
+	IsCompliantDeviceAccepted bool `json:"isCompliantDeviceAccepted"`
+
+	// Whether hybrid Microsoft Entra joined device claims from the external tenant are accepted.
+	IsHybridAzureADJoinedDeviceAccepted bool `json:"isHybridAzureADJoinedDeviceAccepted"`
+}
+
+type CrossTenantAccessPolicyB2BSetting struct {
+	// The users and groups the setting applies to.
+	UsersAndGroups *CrossTenantAccessPolicyTargetConfiguration `json:"usersAndGroups,omitempty"`
+
+	// The applications the setting applies to.
+	Applications *CrossTenantAccessPolicyTargetConfiguration `json:"applications,omitempty"`
+}
+
+type CrossTenantAccessPolicyTargetConfiguration struct {
+	// Whether access is allowed or blocked for the listed targets.
+	// Possible values are allowed and blocked.
+	AccessType string `json:"accessType,omitempty"`
+
+	// The users, groups or applications the access type applies to.
+	Targets []CrossTenantAccessPolicyTarget `json:"targets,omitempty"`
+}
+
+type CrossTenantAccessPolicyTarget struct {
+	// The identifier of the user, group or application, or one of the keywords AllUsers and AllApplications.
+	Target string `json:"target,omitempty"`
+
+	// The type of resource the target refers to.
+	// Possible values are user, group and application.
+	TargetType string `json:"targetType,omitempty"`
+}
+
+type InboundOutboundPolicyConfiguration struct {
+	// Whether inbound access is allowed.
+	InboundAllowed bool `json:"inboundAllowed"`
+
+	// Whether outbound access is allowed.
+	OutboundAllowed bool `json:"outboundAllowed"`
+}
+
+// AllowsAny reports whether at least some of the targets are granted access. A block that lists specific
+// targets still allows everyone else, while a block of AllUsers or AllApplications allows nobody.
+func (s CrossTenantAccessPolicyTargetConfiguration) AllowsAny() bool {
+	if s.AccessType == "allowed" {
+		return len(s.Targets) > 0
+	}
+	for _, target := range s.Targets {
+		if target.Target == "AllUsers" || target.Target == "AllApplications" {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowsAny reports whether at least some users can reach at least some applications under this setting.
+func (s CrossTenantAccessPolicyB2BSetting) AllowsAny() bool {
+	return (s.UsersAndGroups == nil || s.UsersAndGroups.AllowsAny()) &&
+		(s.Applications == nil || s.Applications.AllowsAny())
+}
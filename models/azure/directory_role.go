@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents a Microsoft Entra directory role that has been activated in the tenant.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/directoryrole?view=graph-rest-1.0
+type DirectoryRole struct {
+	DirectoryObject
+
+	// The description for the directory role.
+	Description string `json:"description,omitempty"`
+
+	// The display name for the directory role.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// The id of the directoryRoleTemplate that this role is based on.
+	// This matches the id of the built-in unified role definition.
+	RoleTemplateId string `json:"roleTemplateId,omitempty"`
+}
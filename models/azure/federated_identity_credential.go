@@ -0,0 +1,64 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents a federated identity credential configured on an application. Federated identity credentials allow a
+// workload running on an external identity provider (e.g. GitHub Actions, Kubernetes or another Entra tenant) to
+// exchange a token issued by that provider for a Microsoft identity platform access token as the application.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/federatedidentitycredential?view=graph-rest-1.0
+type FederatedIdentityCredential struct {
+	Entity
+
+	// The audience that can appear in the external token.
+	// The recommended value is api://AzureADTokenExchange.
+	Audiences []string `json:"audiences,omitempty"`
+
+	// The un-validated, user-provided description of the federated identity credential.
+	Description string `json:"description,omitempty"`
+
+	// The URL of the external identity provider and must match the issuer claim of the external token being
+	// exchanged.
+	Issuer string `json:"issuer,omitempty"`
+
+	// The unique identifier for the federated identity credential.
+	Name string `json:"name,omitempty"`
+
+	// The identifier of the external software workload within the external identity provider.
+	// The value must match the sub claim within the token presented to Microsoft Entra ID.
+	Subject string `json:"subject,omitempty"`
+}
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/managedidentity/federated-identity-credentials/list?view=rest-managedidentity-2023-01-31
+type ManagedIdentityFederatedIdentityCredential struct {
+	Entity
+
+	Name       string                                `json:"name,omitempty"`
+	Type       string                                `json:"type,omitempty"`
+	Properties FederatedIdentityCredentialProperties `json:"properties,omitempty"`
+}
+
+type FederatedIdentityCredentialProperties struct {
+	// The list of audiences that can appear in the issued token.
+	Audiences []string `json:"audiences,omitempty"`
+
+	// The URL of the issuer to be trusted.
+	Issuer string `json:"issuer,omitempty"`
+
+	// The identifier of the external identity.
+	Subject string `json:"subject,omitempty"`
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents a device enrolled in Microsoft Intune.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/intune-devices-manageddevice?view=graph-rest-beta
+type IntuneManagedDevice struct {
+	Entity
+
+	// Name of the device.
+	DeviceName string `json:"deviceName,omitempty"`
+
+	// The device id of the corresponding Microsoft Entra device. This is the device's deviceId, not its object id.
+	AzureADDeviceId string `json:"azureADDeviceId,omitempty"`
+
+	// Compliance state of the device.
+	// Possible values are unknown, compliant, noncompliant, conflict, error, inGracePeriod and configManager.
+	ComplianceState string `json:"complianceState,omitempty"`
+
+	// Operating system of the device, e.g. Windows or iOS.
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+
+	// Operating system version of the device.
+	OSVersion string `json:"osVersion,omitempty"`
+
+	// The agent managing the device, e.g. mdm, easMdm or configurationManagerClientMdm.
+	ManagementAgent string `json:"managementAgent,omitempty"`
+
+	// Ownership of the device. Possible values are unknown, company and personal.
+	ManagedDeviceOwnerType string `json:"managedDeviceOwnerType,omitempty"`
+
+	// The enrollment type of the device.
+	DeviceEnrollmentType string `json:"deviceEnrollmentType,omitempty"`
+
+	// The object id of the device's primary user.
+	UserId string `json:"userId,omitempty"`
+
+	// The user principal name of the device's primary user.
+	UserPrincipalName string `json:"userPrincipalName,omitempty"`
+
+	// Whether the device storage is encrypted.
+	IsEncrypted bool `json:"isEncrypted"`
+
+	// Whether the device is jail broken or rooted.
+	JailBroken string `json:"jailBroken,omitempty"`
+
+	// The date and time the device was enrolled.
+	EnrolledDateTime string `json:"enrolledDateTime,omitempty"`
+
+	// The date and time the device last completed a successful sync with Intune.
+	LastSyncDateTime string `json:"lastSyncDateTime,omitempty"`
+
+	// The scope tags applied to the device.
+	RoleScopeTagIds []string `json:"roleScopeTagIds,omitempty"`
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents the assignment of an Intune role to a set of administrators over a set of users and devices.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/intune-rbac-deviceandappmanagementroleassignment?view=graph-rest-beta
+type IntuneRoleAssignment struct {
+	Entity
+
+	// The display name of the role assignment.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description of the role assignment.
+	Description string `json:"description,omitempty"`
+
+	// The ids of the security groups whose members are granted the role.
+	Members []string `json:"members,omitempty"`
+
+	// The ids of the groups whose users and devices the role may be exercised over.
+	ScopeMembers []string `json:"scopeMembers,omitempty"`
+
+	// The ids of the groups the role applies to. Superseded by ScopeMembers.
+	ResourceScopes []string `json:"resourceScopes,omitempty"`
+
+	// Whether the assignment is scoped to AllLicensedUsers, AllDevices or the ScopeMembers.
+	ScopeType string `json:"scopeType,omitempty"`
+
+	// The scope tags that limit which objects the assigned administrators can see and manage.
+	RoleScopeTagIds []string `json:"roleScopeTagIds,omitempty"`
+
+	// The role being assigned. Only returned when expanded.
+	RoleDefinition *IntuneRoleDefinition `json:"roleDefinition,omitempty"`
+}
+
+type IntuneRoleDefinition struct {
+	Entity
+
+	// The display name of the role.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Whether the role is a built-in role.
+	IsBuiltIn bool `json:"isBuiltIn"`
+}
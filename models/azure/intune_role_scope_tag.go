@@ -0,0 +1,33 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents an Intune scope tag, which limits the objects an administrator can see and manage.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/intune-rbac-rolescopetag?view=graph-rest-beta
+type IntuneRoleScopeTag struct {
+	Entity
+
+	// The display name of the scope tag.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description of the scope tag.
+	Description string `json:"description,omitempty"`
+
+	// Whether the scope tag is the built-in Default tag.
+	IsBuiltIn bool `json:"isBuiltIn"`
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"net/url"
+	"strings"
+)
+
+// KeyVaultItemAttributes are the management attributes shared by secrets, keys and certificates. Times are in UTC
+// seconds since the unix epoch.
+type KeyVaultItemAttributes struct {
+	Created         int64  `json:"created,omitempty"`
+	Enabled         bool   `json:"enabled"`
+	Expires         int64  `json:"exp,omitempty"`
+	NotBefore       int64  `json:"nbf,omitempty"`
+	RecoverableDays int    `json:"recoverableDays,omitempty"`
+	RecoveryLevel   string `json:"recoveryLevel,omitempty"`
+	Updated         int64  `json:"updated,omitempty"`
+}
+
+// KeyVaultSecretItem https://learn.microsoft.com/en-us/rest/api/keyvault/secrets/get-secrets/get-secrets?view=rest-keyvault-secrets-7.4#secretitem
+//
+// The list operation never returns secret values and none are mapped here.
+type KeyVaultSecretItem struct {
+	Attributes  KeyVaultItemAttributes `json:"attributes,omitempty"`
+	ContentType string                 `json:"contentType,omitempty"`
+	Id          string                 `json:"id,omitempty"`
+
+	// Set when the secret backs a key vault certificate
+	Managed bool              `json:"managed,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+}
+
+func (s KeyVaultSecretItem) Name() string {
+	return keyVaultItemName(s.Id)
+}
+
+// KeyVaultKeyItem https://learn.microsoft.com/en-us/rest/api/keyvault/keys/get-keys/get-keys?view=rest-keyvault-keys-7.4#keyitem
+type KeyVaultKeyItem struct {
+	Attributes KeyVaultItemAttributes `json:"attributes,omitempty"`
+	Kid        string                 `json:"kid,omitempty"`
+
+	// Set when the key backs a key vault certificate
+	Managed bool              `json:"managed,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+}
+
+func (s KeyVaultKeyItem) Name() string {
+	return keyVaultItemName(s.Kid)
+}
+
+// KeyVaultCertificateItem https://learn.microsoft.com/en-us/rest/api/keyvault/certificates/get-certificates/get-certificates?view=rest-keyvault-certificates-7.4#certificateitem
+type KeyVaultCertificateItem struct {
+	Attributes KeyVaultItemAttributes `json:"attributes,omitempty"`
+	Id         string                 `json:"id,omitempty"`
+	Subject    string                 `json:"subject,omitempty"`
+	Tags       map[string]string      `json:"tags,omitempty"`
+	X5t        string                 `json:"x5t,omitempty"`
+}
+
+func (s KeyVaultCertificateItem) Name() string {
+	return keyVaultItemName(s.Id)
+}
+
+// keyVaultItemName returns the name segment of an item identifier such as https://myvault.vault.azure.net/secrets/name
+func keyVaultItemName(id string) string {
+	if u, err := url.Parse(id); err != nil {
+		return ""
+	} else if parts := strings.Split(strings.Trim(u.Path, "/"), "/"); len(parts) < 2 {
+		return ""
+	} else {
+		return parts[1]
+	}
+}
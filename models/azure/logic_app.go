@@ -17,7 +17,11 @@
 
 package azure
 
-import "strings"
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+)
 
 type LogicApp struct {
 	Entity
@@ -47,3 +51,44 @@ func (s LogicApp) ResourceGroupId() string {
 		return ""
 	}
 }
+
+// ApiConnectionReference is an entry of a workflow's $connections parameter
+type ApiConnectionReference struct {
+	// The id of the Microsoft.Web/connections resource
+	ConnectionId   string `json:"connectionId"`
+	ConnectionName string `json:"connectionName"`
+
+	// The id of the managed API the connection is for
+	Id string `json:"id"`
+}
+
+// ApiConnections parses the API connections the workflow references from its $connections parameter. The parameter's
+// value is normally set on the workflow, but may instead be the default value of the definition's parameter.
+func (s LogicApp) ApiConnections() []ApiConnectionReference {
+	var value interface{}
+	if parameter, ok := s.Properties.Parameters["$connections"]; ok && parameter.Value != nil {
+		value = parameter.Value
+	} else if parameter, ok := s.Properties.Definition.Parameters["$connections"]; ok {
+		value = parameter.DefaultValue
+	}
+
+	var connections map[string]ApiConnectionReference
+	if value == nil {
+		return nil
+	} else if bytes, err := json.Marshal(value); err != nil {
+		return nil
+	} else if err := json.Unmarshal(bytes, &connections); err != nil {
+		return nil
+	}
+
+	references := make([]ApiConnectionReference, 0, len(connections))
+	for _, connection := range connections {
+		if connection.ConnectionId != "" {
+			references = append(references, connection)
+		}
+	}
+	sort.Slice(references, func(i, j int) bool {
+		return references[i].ConnectionId < references[j].ConnectionId
+	})
+	return references
+}
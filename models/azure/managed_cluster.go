@@ -50,3 +50,26 @@ func (s ManagedCluster) ResourceGroupId() string {
 		return ""
 	}
 }
+
+// KubeletIdentity returns the user-assigned identity the cluster's nodes use to authenticate to Azure
+func (s ManagedCluster) KubeletIdentity() (ManagedClusterUserAssignedIdentity, bool) {
+	identity, ok := s.Properties.IdentityProfile["kubeletidentity"]
+	return identity, ok && identity.ResourceId != ""
+}
+
+// AddonIdentities returns the user-assigned identities of the cluster's enabled add-ons, keyed by add-on name. Like the
+// kubelet identity they are attached to the cluster's node pools.
+func (s ManagedCluster) AddonIdentities() map[string]ManagedClusterUserAssignedIdentity {
+	identities := make(map[string]ManagedClusterUserAssignedIdentity)
+	for name, addon := range s.Properties.AddonProfiles {
+		if addon.Enabled && addon.Identity.ResourceId != "" {
+			identities[name] = addon.Identity
+		}
+	}
+	return identities
+}
+
+// PublicAPIServer reports whether the cluster's API server is reachable from public networks
+func (s ManagedCluster) PublicAPIServer() bool {
+	return !s.Properties.ApiServerAccessProfile.EnablePrivateCluster && s.Properties.PublicNetworkAccess != "Disabled"
+}
@@ -17,8 +17,93 @@
 
 package azure
 
-// Properties of the vault
+// Properties of the managed cluster
 type ManagedClusterProperties struct {
+	// The Azure Active Directory configuration of the cluster
+	AadProfile ManagedClusterAADProfile `json:"aadProfile,omitempty"`
+
+	// The add-ons enabled on the cluster, keyed by add-on name
+	AddonProfiles map[string]ManagedClusterAddonProfile `json:"addonProfiles,omitempty"`
+
+	// The node pools of the cluster
+	AgentPoolProfiles []ManagedClusterAgentPoolProfile `json:"agentPoolProfiles,omitempty"`
+
+	// Access profile for the cluster's API server
+	ApiServerAccessProfile ManagedClusterAPIServerAccessProfile `json:"apiServerAccessProfile,omitempty"`
+
+	// If set to true, getting static credentials will be disabled for this cluster. This must only be used on Managed
+	// Clusters that are AAD enabled.
+	DisableLocalAccounts bool `json:"disableLocalAccounts,omitempty"`
+
+	// Whether Kubernetes Role-Based Access Control is enabled
+	EnableRBAC bool `json:"enableRBAC,omitempty"`
+
+	// The FQDN of the master pool
+	Fqdn string `json:"fqdn,omitempty"`
+
+	// The identities used by the cluster's nodes, keyed by use. The kubelet identity is found under "kubeletidentity".
+	IdentityProfile map[string]ManagedClusterUserAssignedIdentity `json:"identityProfile,omitempty"`
+
+	// The version of Kubernetes the cluster is running
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
 	// The name of the AzureRM Resource Group the Managed Cluster's Virtual Machine Scale Set resides
 	NodeResourceGroup string `json:"nodeResourceGroup,omitempty"`
+
+	// The FQDN of the private cluster
+	PrivateFQDN string `json:"privateFQDN,omitempty"`
+
+	// Allow or deny public network access for the cluster
+	PublicNetworkAccess string `json:"publicNetworkAccess,omitempty"`
+}
+
+type ManagedClusterAADProfile struct {
+	// The list of AAD group object IDs that will have the admin role of the cluster
+	AdminGroupObjectIDs []string `json:"adminGroupObjectIDs,omitempty"`
+
+	// Whether to enable Azure RBAC for Kubernetes authorization
+	EnableAzureRBAC bool `json:"enableAzureRBAC,omitempty"`
+
+	// Whether to enable managed AAD
+	Managed bool `json:"managed,omitempty"`
+
+	// The AAD tenant ID to use for authentication
+	TenantID string `json:"tenantID,omitempty"`
+}
+
+type ManagedClusterAddonProfile struct {
+	Config   map[string]string                  `json:"config,omitempty"`
+	Enabled  bool                               `json:"enabled,omitempty"`
+	Identity ManagedClusterUserAssignedIdentity `json:"identity,omitempty"`
+}
+
+type ManagedClusterAgentPoolProfile struct {
+	Count              int    `json:"count,omitempty"`
+	EnableNodePublicIP bool   `json:"enableNodePublicIP,omitempty"`
+	Mode               string `json:"mode,omitempty"`
+	Name               string `json:"name,omitempty"`
+	OsType             string `json:"osType,omitempty"`
+	VmSize             string `json:"vmSize,omitempty"`
+}
+
+type ManagedClusterAPIServerAccessProfile struct {
+	// The IP ranges authorized to access the Kubernetes API server
+	AuthorizedIPRanges []string `json:"authorizedIPRanges,omitempty"`
+
+	// Whether to disable run command for the cluster
+	DisableRunCommand bool `json:"disableRunCommand,omitempty"`
+
+	// Whether to create the cluster as a private cluster
+	EnablePrivateCluster bool `json:"enablePrivateCluster,omitempty"`
+
+	// Whether to create an additional public FQDN for a private cluster
+	EnablePrivateClusterPublicFQDN bool `json:"enablePrivateClusterPublicFQDN,omitempty"`
+}
+
+// ManagedClusterUserAssignedIdentity is a user-assigned identity used by a managed cluster. Unlike the identities of
+// other resources it carries the identity's resource id and refers to its principal as objectId.
+type ManagedClusterUserAssignedIdentity struct {
+	ClientId   string `json:"clientId,omitempty"`
+	ObjectId   string `json:"objectId,omitempty"`
+	ResourceId string `json:"resourceId,omitempty"`
 }
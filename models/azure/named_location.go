@@ -0,0 +1,60 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents a Microsoft Entra named location defined by IP ranges or by countries and regions.
+// Named locations are referenced by conditional access policies.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/namedlocation?view=graph-rest-1.0
+type NamedLocation struct {
+	DirectoryObject
+
+	// The date and time the location was created.
+	CreatedDateTime string `json:"createdDateTime,omitempty"`
+
+	// The date and time the location was last modified.
+	ModifiedDateTime string `json:"modifiedDateTime,omitempty"`
+
+	// Human-readable name of the location.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Set to true if this location is explicitly trusted.
+	// Only applies to ipNamedLocation.
+	IsTrusted bool `json:"isTrusted,omitempty"`
+
+	// List of IP address ranges in IPv4 CIDR format or IPv6 CIDR format.
+	// Only applies to ipNamedLocation.
+	IpRanges []IpRange `json:"ipRanges,omitempty"`
+
+	// List of countries and/or regions in two-letter format specified by ISO 3166-2.
+	// Only applies to countryNamedLocation.
+	CountriesAndRegions []string `json:"countriesAndRegions,omitempty"`
+
+	// Determines what method is used to decide which country the user is located in.
+	// Possible values are clientIpAddress and authenticatorAppGps.
+	// Only applies to countryNamedLocation.
+	CountryLookupMethod string `json:"countryLookupMethod,omitempty"`
+
+	// True if IP addresses that don't map to a country or region should be included in the named location.
+	// Only applies to countryNamedLocation.
+	IncludeUnknownCountriesAndRegions bool `json:"includeUnknownCountriesAndRegions,omitempty"`
+}
+
+type IpRange struct {
+	// The IPv4 or IPv6 CIDR-formatted address range.
+	CidrAddress string `json:"cidrAddress,omitempty"`
+}
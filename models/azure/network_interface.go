@@ -0,0 +1,76 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+type NetworkInterface struct {
+	Entity
+
+	Location   string                     `json:"location,omitempty"`
+	Name       string                     `json:"name,omitempty"`
+	Properties NetworkInterfaceProperties `json:"properties,omitempty"`
+	Tags       map[string]string          `json:"tags,omitempty"`
+	Type       string                     `json:"type,omitempty"`
+}
+
+type NetworkInterfaceProperties struct {
+	EnableIPForwarding   bool                              `json:"enableIPForwarding,omitempty"`
+	IPConfigurations     []NetworkInterfaceIPConfiguration `json:"ipConfigurations,omitempty"`
+	MacAddress           string                            `json:"macAddress,omitempty"`
+	NetworkSecurityGroup SubResource                       `json:"networkSecurityGroup,omitempty"`
+	Primary              bool                              `json:"primary,omitempty"`
+	ProvisioningState    string                            `json:"provisioningState,omitempty"`
+
+	// The virtual machine the interface is attached to, if any.
+	VirtualMachine SubResource `json:"virtualMachine,omitempty"`
+}
+
+type NetworkInterfaceIPConfiguration struct {
+	Id         string                                    `json:"id,omitempty"`
+	Name       string                                    `json:"name,omitempty"`
+	Properties NetworkInterfaceIPConfigurationProperties `json:"properties,omitempty"`
+}
+
+type NetworkInterfaceIPConfigurationProperties struct {
+	ApplicationSecurityGroups []SubResource `json:"applicationSecurityGroups,omitempty"`
+	Primary                   bool          `json:"primary,omitempty"`
+	PrivateIPAddress          string        `json:"privateIPAddress,omitempty"`
+	PrivateIPAddressVersion   string        `json:"privateIPAddressVersion,omitempty"`
+	PrivateIPAllocationMethod string        `json:"privateIPAllocationMethod,omitempty"`
+	PublicIPAddress           SubResource   `json:"publicIPAddress,omitempty"`
+	Subnet                    SubResource   `json:"subnet,omitempty"`
+}
+
+func (s NetworkInterface) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s NetworkInterface) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
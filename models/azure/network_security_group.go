@@ -0,0 +1,135 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"sort"
+	"strings"
+)
+
+type NetworkSecurityGroup struct {
+	Entity
+
+	Location   string                         `json:"location,omitempty"`
+	Name       string                         `json:"name,omitempty"`
+	Properties NetworkSecurityGroupProperties `json:"properties,omitempty"`
+	Tags       map[string]string              `json:"tags,omitempty"`
+	Type       string                         `json:"type,omitempty"`
+}
+
+type NetworkSecurityGroupProperties struct {
+	// The rules every group has, e.g. DenyAllInBound, which apply after the user defined rules.
+	DefaultSecurityRules []SecurityRule `json:"defaultSecurityRules,omitempty"`
+
+	NetworkInterfaces []SubResource  `json:"networkInterfaces,omitempty"`
+	ProvisioningState string         `json:"provisioningState,omitempty"`
+	SecurityRules     []SecurityRule `json:"securityRules,omitempty"`
+	Subnets           []SubResource  `json:"subnets,omitempty"`
+}
+
+type SecurityRule struct {
+	Id         string                 `json:"id,omitempty"`
+	Name       string                 `json:"name,omitempty"`
+	Properties SecurityRuleProperties `json:"properties,omitempty"`
+}
+
+type SecurityRuleProperties struct {
+	// Allow or Deny
+	Access string `json:"access,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	DestinationAddressPrefix   string   `json:"destinationAddressPrefix,omitempty"`
+	DestinationAddressPrefixes []string `json:"destinationAddressPrefixes,omitempty"`
+
+	DestinationApplicationSecurityGroups []SubResource `json:"destinationApplicationSecurityGroups,omitempty"`
+
+	// A port or range such as 22 or 8000-8080, or * for all ports
+	DestinationPortRange  string   `json:"destinationPortRange,omitempty"`
+	DestinationPortRanges []string `json:"destinationPortRanges,omitempty"`
+
+	// Inbound or Outbound
+	Direction string `json:"direction,omitempty"`
+
+	// Rules are evaluated in ascending priority order and the first match wins
+	Priority int `json:"priority,omitempty"`
+
+	// Tcp, Udp, Icmp, Esp, Ah or *
+	Protocol string `json:"protocol,omitempty"`
+
+	SourceAddressPrefix   string   `json:"sourceAddressPrefix,omitempty"`
+	SourceAddressPrefixes []string `json:"sourceAddressPrefixes,omitempty"`
+
+	SourceApplicationSecurityGroups []SubResource `json:"sourceApplicationSecurityGroups,omitempty"`
+
+	SourcePortRange  string   `json:"sourcePortRange,omitempty"`
+	SourcePortRanges []string `json:"sourcePortRanges,omitempty"`
+}
+
+// EffectiveInboundRules returns the group's inbound user defined and default rules in the order they are evaluated
+func (s NetworkSecurityGroup) EffectiveInboundRules() []SecurityRule {
+	var rules []SecurityRule
+	for _, rule := range append(append([]SecurityRule{}, s.Properties.SecurityRules...), s.Properties.DefaultSecurityRules...) {
+		if strings.EqualFold(rule.Properties.Direction, "Inbound") {
+			rules = append(rules, rule)
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Properties.Priority < rules[j].Properties.Priority
+	})
+	return rules
+}
+
+func (s SecurityRule) SourceAddressPrefixes() []string {
+	return appendNonEmpty(s.Properties.SourceAddressPrefixes, s.Properties.SourceAddressPrefix)
+}
+
+func (s SecurityRule) DestinationAddressPrefixes() []string {
+	return appendNonEmpty(s.Properties.DestinationAddressPrefixes, s.Properties.DestinationAddressPrefix)
+}
+
+func (s SecurityRule) DestinationPortRanges() []string {
+	return appendNonEmpty(s.Properties.DestinationPortRanges, s.Properties.DestinationPortRange)
+}
+
+// The api sets either the singular or plural form of a rule's prefixes and port ranges
+func appendNonEmpty(values []string, value string) []string {
+	if value == "" {
+		return values
+	} else {
+		return append(append([]string{}, values...), value)
+	}
+}
+
+func (s NetworkSecurityGroup) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s NetworkSecurityGroup) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents the delegated permissions that have been granted to an application's service principal.
+//
+// Delegated permissions grants can be created as a result of a user consenting the an application's request to access
+// an API, or created directly. A grant either applies to all users of the tenant (AllPrincipals) or to a single user
+// (Principal).
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/oauth2permissiongrant?view=graph-rest-1.0
+type OAuth2PermissionGrant struct {
+	Entity
+
+	// The object id (not appId) of the client service principal for the application which is authorized to act on
+	// behalf of a signed-in user when accessing an API.
+	ClientId string `json:"clientId,omitempty"`
+
+	// Indicates if authorization is granted for the client application to impersonate all users or only a specific
+	// user. AllPrincipals indicates authorization to impersonate all users. Principal indicates authorization to
+	// impersonate a specific user.
+	ConsentType string `json:"consentType,omitempty"`
+
+	// The id of the user on behalf of whom the client is authorized to access the resource, when consentType is
+	// Principal. If consentType is AllPrincipals this value is null.
+	PrincipalId string `json:"principalId,omitempty"`
+
+	// The id of the resource service principal to which access is authorized. This identifies the API which the
+	// client is authorized to attempt to call on behalf of a signed-in user.
+	ResourceId string `json:"resourceId,omitempty"`
+
+	// A space-separated list of the claim values for delegated permissions which should be included in access tokens
+	// for the resource application (the API).
+	Scope string `json:"scope,omitempty"`
+}
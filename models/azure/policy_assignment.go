@@ -0,0 +1,82 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// The policy assignment.
+// For more detail see https://learn.microsoft.com/en-us/rest/api/policy/policy-assignments/list-for-management-group?view=rest-policy-2023-04-01#policyassignment
+type PolicyAssignment struct {
+	// The ID of the policy assignment.
+	Id string `json:"id,omitempty"`
+
+	// The name of the policy assignment.
+	Name string `json:"name,omitempty"`
+
+	// The type of the policy assignment.
+	Type string `json:"type,omitempty"`
+
+	// The location of the policy assignment. Only required when utilizing managed identity.
+	Location string `json:"location,omitempty"`
+
+	// The managed identity associated with the policy assignment. Remediation tasks for deployIfNotExists and modify
+	// policies run as this identity.
+	Identity ManagedIdentity `json:"identity,omitempty"`
+
+	// Properties for the policy assignment.
+	Properties PolicyAssignmentProperties `json:"properties,omitempty"`
+}
+
+// IsWithinScope reports whether the policy assignment is assigned at or below the given scope.
+func (s PolicyAssignment) IsWithinScope(scope string) bool {
+	var (
+		assignmentScope = strings.ToLower(s.Properties.Scope)
+		target          = strings.TrimSuffix(strings.ToLower(scope), "/")
+	)
+	return assignmentScope == target || strings.HasPrefix(assignmentScope, target+"/")
+}
+
+type PolicyAssignmentProperties struct {
+	// The display name of the policy assignment.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// This message will be part of response in case of policy violation.
+	Description string `json:"description,omitempty"`
+
+	// The ID of the policy definition or policy set definition being assigned.
+	PolicyDefinitionId string `json:"policyDefinitionId,omitempty"`
+
+	// The scope for the policy assignment.
+	Scope string `json:"scope,omitempty"`
+
+	// The policy's excluded scopes.
+	NotScopes []string `json:"notScopes,omitempty"`
+
+	// The parameter values for the assigned policy rule. The keys are the parameter names.
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+
+	// The policy assignment enforcement mode. E.g. Default, DoNotEnforce
+	EnforcementMode string `json:"enforcementMode,omitempty"`
+
+	// The policy assignment metadata. Metadata is an open ended object and is typically a collection of key value
+	// pairs.
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+}
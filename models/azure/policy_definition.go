@@ -0,0 +1,118 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// The policy definition or policy set definition (initiative) referenced by a policy assignment.
+// For more detail see https://learn.microsoft.com/en-us/rest/api/policy/policy-definitions/get?view=rest-policy-2023-04-01#policydefinition
+// and https://learn.microsoft.com/en-us/rest/api/policy/policy-set-definitions/get?view=rest-policy-2023-04-01#policysetdefinition
+type PolicyDefinition struct {
+	// The ID of the policy definition.
+	Id string `json:"id,omitempty"`
+
+	// The name of the policy definition.
+	Name string `json:"name,omitempty"`
+
+	// The type of the resource. E.g. Microsoft.Authorization/policyDefinitions,
+	// Microsoft.Authorization/policySetDefinitions
+	Type string `json:"type,omitempty"`
+
+	// The policy definition properties.
+	Properties PolicyDefinitionProperties `json:"properties,omitempty"`
+}
+
+// IsPolicySet reports whether this is a policy set definition (initiative) grouping other policy definitions.
+func (s PolicyDefinition) IsPolicySet() bool {
+	return strings.EqualFold(s.Type, "Microsoft.Authorization/policySetDefinitions")
+}
+
+// Effect returns the effect of the policy rule and, for deployIfNotExists and modify effects, the role definitions
+// that remediation requires the assignment's managed identity to hold.
+func (s PolicyDefinition) Effect() (string, []string) {
+	var (
+		rule struct {
+			Then PolicyRuleThen `json:"then"`
+		}
+		details struct {
+			RoleDefinitionIds []string `json:"roleDefinitionIds"`
+		}
+	)
+
+	if len(s.Properties.PolicyRule) == 0 {
+		return "", nil
+	} else if err := json.Unmarshal(s.Properties.PolicyRule, &rule); err != nil {
+		return "", nil
+	} else if err := json.Unmarshal(rule.Then.Details, &details); err != nil {
+		return rule.Then.Effect, nil
+	} else {
+		return rule.Then.Effect, details.RoleDefinitionIds
+	}
+}
+
+type PolicyDefinitionProperties struct {
+	// The display name of the policy definition.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// The policy definition description.
+	Description string `json:"description,omitempty"`
+
+	// The type of policy definition. E.g. NotSpecified, BuiltIn, Custom, Static
+	PolicyType string `json:"policyType,omitempty"`
+
+	// The policy definition mode. E.g. All, Indexed
+	Mode string `json:"mode,omitempty"`
+
+	// The parameter definitions for parameters used in the policy rule.
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+
+	// The policy rule. Only populated for policy definitions.
+	PolicyRule json.RawMessage `json:"policyRule,omitempty"`
+
+	// The policy definitions grouped by a policy set definition. Only populated for policy set definitions.
+	PolicyDefinitions []PolicyDefinitionReference `json:"policyDefinitions,omitempty"`
+
+	// The policy definition metadata. Metadata is an open ended object and is typically a collection of key value
+	// pairs.
+	Metadata json.RawMessage `json:"metadata,omitempty"`
+}
+
+// The policy definition reference of a policy set definition.
+type PolicyDefinitionReference struct {
+	// The ID of the policy definition or policy set definition.
+	PolicyDefinitionId string `json:"policyDefinitionId,omitempty"`
+
+	// A unique id (within the policy set definition) for this policy definition reference.
+	PolicyDefinitionReferenceId string `json:"policyDefinitionReferenceId,omitempty"`
+
+	// The parameter values for the referenced policy rule. The keys are the parameter names.
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// The effect of a policy rule.
+type PolicyRuleThen struct {
+	// The effect of the policy rule. E.g. deny, audit, deployIfNotExists, modify or a parameter reference such as
+	// [parameters('effect')]
+	Effect string `json:"effect,omitempty"`
+
+	// Effect specific details. This is an object for most effects but an array for append.
+	Details json.RawMessage `json:"details,omitempty"`
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Common properties of the schedule instances for Privileged Identity Management (PIM) for Groups.
+type PrivilegedAccessGroupScheduleInstance struct {
+	Entity
+
+	// The identifier of the membership or ownership assignment relationship to the group.
+	// The possible values are: owner, member.
+	AccessId string `json:"accessId,omitempty"`
+
+	// The identifier of the group representing the scope of the membership or ownership through PIM for groups.
+	GroupId string `json:"groupId,omitempty"`
+
+	// The identifier of the principal whose membership or ownership to the group is managed through PIM for groups.
+	PrincipalId string `json:"principalId,omitempty"`
+
+	// Indicates whether the principal's relationship to the group was granted directly or through membership of
+	// another group. The possible values are: direct, group.
+	MemberType string `json:"memberType,omitempty"`
+
+	// When the schedule instance starts.
+	StartDateTime string `json:"startDateTime,omitempty"`
+
+	// When the schedule instance ends. If null, the instance is permanent.
+	EndDateTime string `json:"endDateTime,omitempty"`
+}
+
+// Represents the instance of an eligibility schedule for a membership or ownership of a group managed through PIM
+// for Groups.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/privilegedaccessgroupeligibilityscheduleinstance?view=graph-rest-1.0
+type PrivilegedAccessGroupEligibilityScheduleInstance struct {
+	PrivilegedAccessGroupScheduleInstance
+
+	// The identifier of the eligibility schedule from which this instance was created.
+	EligibilityScheduleId string `json:"eligibilityScheduleId,omitempty"`
+}
+
+// Represents the instance of an assignment schedule for a membership or ownership of a group managed through PIM for
+// Groups.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/privilegedaccessgroupassignmentscheduleinstance?view=graph-rest-1.0
+type PrivilegedAccessGroupAssignmentScheduleInstance struct {
+	PrivilegedAccessGroupScheduleInstance
+
+	// The identifier of the assignment schedule from which this instance was created.
+	AssignmentScheduleId string `json:"assignmentScheduleId,omitempty"`
+
+	// Indicates whether the membership or ownership assignment is granted through activation of an eligibility or
+	// through direct assignment. The possible values are: assigned, activated.
+	AssignmentType string `json:"assignmentType,omitempty"`
+}
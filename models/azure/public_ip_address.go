@@ -0,0 +1,69 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import (
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+)
+
+type PublicIPAddress struct {
+	Entity
+
+	Location   string                    `json:"location,omitempty"`
+	Name       string                    `json:"name,omitempty"`
+	Properties PublicIPAddressProperties `json:"properties,omitempty"`
+	Sku        VMPublicIPSku             `json:"sku,omitempty"`
+	Tags       map[string]string         `json:"tags,omitempty"`
+	Type       string                    `json:"type,omitempty"`
+	Zones      []string                  `json:"zones,omitempty"`
+}
+
+type PublicIPAddressProperties struct {
+	DNSSettings VMPublicIPDNSSettings `json:"dnsSettings,omitempty"`
+
+	// The address allocated to the resource. Dynamic addresses are only allocated while the resource they are
+	// associated with is running.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// The IP configuration the address is associated with, e.g. a network interface or load balancer frontend.
+	IPConfiguration SubResource `json:"ipConfiguration,omitempty"`
+
+	ProvisioningState        string                   `json:"provisioningState,omitempty"`
+	PublicIPAddressVersion   string                   `json:"publicIPAddressVersion,omitempty"`
+	PublicIPAllocationMethod enums.IPAllocationMethod `json:"publicIPAllocationMethod,omitempty"`
+}
+
+func (s PublicIPAddress) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s PublicIPAddress) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/appservice/web-apps/get-scm-allowed?view=rest-appservice-2022-03-01#csmpublishingcredentialspoliciesentity
+//
+// A site has one policy named "scm" and one named "ftp".
+type PublishingCredentialsPolicy struct {
+	Entity
+
+	Kind       string                                `json:"kind,omitempty"`
+	Name       string                                `json:"name,omitempty"`
+	Properties PublishingCredentialsPolicyProperties `json:"properties,omitempty"`
+	Type       string                                `json:"type,omitempty"`
+}
+
+type PublishingCredentialsPolicyProperties struct {
+	// Whether basic authentication with the site's publishing credentials is allowed
+	Allow bool `json:"allow"`
+}
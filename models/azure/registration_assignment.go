@@ -0,0 +1,86 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// RegistrationAssignment is an Azure Lighthouse delegation of a subscription or resource group to principals in a
+// managing tenant, under the terms of its registration definition.
+type RegistrationAssignment struct {
+	Entity
+
+	Name       string                           `json:"name,omitempty"`
+	Properties RegistrationAssignmentProperties `json:"properties,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type RegistrationAssignmentProperties struct {
+	ProvisioningState string `json:"provisioningState,omitempty"`
+
+	// Only returned when the registration definition is expanded
+	RegistrationDefinition RegistrationDefinition `json:"registrationDefinition,omitempty"`
+
+	RegistrationDefinitionId string `json:"registrationDefinitionId,omitempty"`
+}
+
+// RegistrationDefinition https://learn.microsoft.com/en-us/rest/api/managedservices/registration-definitions/get?view=rest-managedservices-2022-10-01#registrationdefinition
+type RegistrationDefinition struct {
+	Entity
+
+	Name       string                           `json:"name,omitempty"`
+	Properties RegistrationDefinitionProperties `json:"properties,omitempty"`
+	Type       string                           `json:"type,omitempty"`
+}
+
+type RegistrationDefinitionProperties struct {
+	// The principals of the managing tenant that hold a role on the delegated scope
+	Authorizations []LighthouseAuthorization `json:"authorizations,omitempty"`
+
+	Description string `json:"description,omitempty"`
+
+	// The principals of the managing tenant that may activate a role on the delegated scope just in time
+	EligibleAuthorizations []LighthouseEligibleAuthorization `json:"eligibleAuthorizations,omitempty"`
+
+	ManagedByTenantId          string `json:"managedByTenantId,omitempty"`
+	ManagedByTenantName        string `json:"managedByTenantName,omitempty"`
+	ManageeTenantId            string `json:"manageeTenantId,omitempty"`
+	ManageeTenantName          string `json:"manageeTenantName,omitempty"`
+	ProvisioningState          string `json:"provisioningState,omitempty"`
+	RegistrationDefinitionName string `json:"registrationDefinitionName,omitempty"`
+}
+
+type LighthouseAuthorization struct {
+	// The roles a principal holding User Access Administrator may assign to managed identities in the delegated scope
+	DelegatedRoleDefinitionIds []string `json:"delegatedRoleDefinitionIds,omitempty"`
+
+	PrincipalId            string `json:"principalId,omitempty"`
+	PrincipalIdDisplayName string `json:"principalIdDisplayName,omitempty"`
+	RoleDefinitionId       string `json:"roleDefinitionId,omitempty"`
+}
+
+type LighthouseEligibleAuthorization struct {
+	JustInTimeAccessPolicy LighthouseJustInTimeAccessPolicy `json:"justInTimeAccessPolicy,omitempty"`
+	PrincipalId            string                           `json:"principalId,omitempty"`
+	PrincipalIdDisplayName string                           `json:"principalIdDisplayName,omitempty"`
+	RoleDefinitionId       string                           `json:"roleDefinitionId,omitempty"`
+}
+
+type LighthouseJustInTimeAccessPolicy struct {
+	MaximumActivationDuration string `json:"maximumActivationDuration,omitempty"`
+
+	// None or Azure
+	MultiFactorAuthProvider string `json:"multiFactorAuthProvider,omitempty"`
+}
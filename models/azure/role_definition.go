@@ -0,0 +1,125 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Role definition for Azure RBAC.
+// For more detail see https://learn.microsoft.com/en-us/rest/api/authorization/role-definitions/list?view=rest-authorization-2022-04-01#roledefinition
+type RoleDefinition struct {
+	// The role definition ID.
+	Id string `json:"id,omitempty"`
+
+	// The role definition name. This is the GUID referenced by role assignments.
+	Name string `json:"name,omitempty"`
+
+	// The role definition type.
+	Type string `json:"type,omitempty"`
+
+	// Role definition properties.
+	Properties RoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+// GrantsAction reports whether the role definition permits the given control plane action once its NotActions have
+// been taken into account.
+func (s RoleDefinition) GrantsAction(action string) bool {
+	for _, permission := range s.Properties.Permissions {
+		if matchesAnyPermission(permission.Actions, action) && !matchesAnyPermission(permission.NotActions, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// GrantsDataAction reports whether the role definition permits the given data plane action once its NotDataActions
+// have been taken into account.
+func (s RoleDefinition) GrantsDataAction(action string) bool {
+	for _, permission := range s.Properties.Permissions {
+		if matchesAnyPermission(permission.DataActions, action) && !matchesAnyPermission(permission.NotDataActions, action) {
+			return true
+		}
+	}
+	return false
+}
+
+type RoleDefinitionProperties struct {
+	// The role name.
+	RoleName string `json:"roleName,omitempty"`
+
+	// The role type. E.g. BuiltInRole, CustomRole
+	Type string `json:"type,omitempty"`
+
+	// The role definition description.
+	Description string `json:"description,omitempty"`
+
+	// Role definition permissions.
+	Permissions []RoleDefinitionPermission `json:"permissions,omitempty"`
+
+	// Role definition assignable scopes.
+	AssignableScopes []string `json:"assignableScopes,omitempty"`
+}
+
+// Role definition permissions. Each entry may contain wildcards, e.g. Microsoft.Compute/*
+type RoleDefinitionPermission struct {
+	// Allowed actions.
+	Actions []string `json:"actions,omitempty"`
+
+	// Denied actions.
+	NotActions []string `json:"notActions,omitempty"`
+
+	// Allowed data actions.
+	DataActions []string `json:"dataActions,omitempty"`
+
+	// Denied data actions.
+	NotDataActions []string `json:"notDataActions,omitempty"`
+}
+
+func matchesAnyPermission(patterns []string, action string) bool {
+	for _, pattern := range patterns {
+		if matchesPermission(pattern, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPermission performs a case-insensitive match of an action against a permission pattern in which each '*'
+// matches any sequence of characters.
+func matchesPermission(pattern, action string) bool {
+	var (
+		parts     = strings.Split(strings.ToLower(pattern), "*")
+		remaining = strings.ToLower(action)
+	)
+
+	if !strings.HasPrefix(remaining, parts[0]) {
+		return false
+	}
+	remaining = remaining[len(parts[0]):]
+
+	for i, part := range parts[1:] {
+		if i == len(parts)-2 {
+			return strings.HasSuffix(remaining, part)
+		} else if idx := strings.Index(remaining, part); idx < 0 {
+			return false
+		} else {
+			remaining = remaining[idx+len(part):]
+		}
+	}
+
+	return remaining == ""
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Information about an instance of an Azure RBAC role eligibility schedule managed through Privileged Identity
+// Management (PIM).
+// For more detail see https://learn.microsoft.com/en-us/rest/api/authorization/role-eligibility-schedule-instances/list-for-scope?view=rest-authorization-2020-10-01#roleeligibilityscheduleinstance
+type RoleEligibilityScheduleInstance struct {
+	// The role eligibility schedule instance ID.
+	Id string `json:"id,omitempty"`
+
+	// The role eligibility schedule instance name.
+	Name string `json:"name,omitempty"`
+
+	// The role eligibility schedule instance type.
+	Type string `json:"type,omitempty"`
+
+	// Role eligibility schedule instance properties.
+	Properties RoleEligibilityScheduleInstanceProperties `json:"properties,omitempty"`
+}
+
+func (s RoleEligibilityScheduleInstance) GetPrincipalId() string {
+	return s.Properties.PrincipalId
+}
+
+// IsWithinScope reports whether the instance applies at or below the given scope.
+func (s RoleEligibilityScheduleInstance) IsWithinScope(scope string) bool {
+	var (
+		instanceScope = strings.ToLower(s.Properties.Scope)
+		target        = strings.TrimSuffix(strings.ToLower(scope), "/")
+	)
+	return instanceScope == target || strings.HasPrefix(instanceScope, target+"/")
+}
+
+type RoleEligibilityScheduleInstanceProperties struct {
+	// The role eligibility schedule scope.
+	Scope string `json:"scope,omitempty"`
+
+	// The role definition ID.
+	RoleDefinitionId string `json:"roleDefinitionId,omitempty"`
+
+	// The principal ID.
+	PrincipalId string `json:"principalId,omitempty"`
+
+	// The principal type of the assigned principal ID. E.g. User, Group, ServicePrincipal
+	PrincipalType string `json:"principalType,omitempty"`
+
+	// The role eligibility schedule ID from which this instance was created.
+	RoleEligibilityScheduleId string `json:"roleEligibilityScheduleId,omitempty"`
+
+	// The status of the role eligibility schedule instance.
+	Status string `json:"status,omitempty"`
+
+	// The start date time of the role eligibility schedule instance.
+	StartDateTime string `json:"startDateTime,omitempty"`
+
+	// The end date time of the role eligibility schedule instance. If empty, the eligibility is permanent.
+	EndDateTime string `json:"endDateTime,omitempty"`
+
+	// Membership type of the role eligibility schedule. E.g. Direct, Group, Inherited
+	MemberType string `json:"memberType,omitempty"`
+
+	// The conditions on the role assignment. This limits the resources it can be assigned to.
+	Condition string `json:"condition,omitempty"`
+
+	// Version of the condition.
+	ConditionVersion string `json:"conditionVersion,omitempty"`
+
+	// Date time when the role eligibility schedule instance was created.
+	CreatedOn string `json:"createdOn,omitempty"`
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Role management policy assignment for an Azure RBAC role at a given scope. The policy governs how eligible and
+// active assignments of the role may be created and activated through Privileged Identity Management (PIM).
+// For more detail see https://learn.microsoft.com/en-us/rest/api/authorization/role-management-policy-assignments/list-for-scope?view=rest-authorization-2020-10-01#rolemanagementpolicyassignment
+type RoleManagementPolicyAssignment struct {
+	// The role management policy assignment ID.
+	Id string `json:"id,omitempty"`
+
+	// The role management policy assignment name.
+	Name string `json:"name,omitempty"`
+
+	// The role management policy assignment type.
+	Type string `json:"type,omitempty"`
+
+	// Role management policy assignment properties.
+	Properties RoleManagementPolicyAssignmentProperties `json:"properties,omitempty"`
+}
+
+type RoleManagementPolicyAssignmentProperties struct {
+	// The role management policy scope.
+	Scope string `json:"scope,omitempty"`
+
+	// The role definition of the management policy assignment.
+	RoleDefinitionId string `json:"roleDefinitionId,omitempty"`
+
+	// The policy ID of the role management policy assignment.
+	PolicyId string `json:"policyId,omitempty"`
+
+	// The readonly computed rules applied to the policy.
+	EffectiveRules []RoleManagementPolicyRule `json:"effectiveRules,omitempty"`
+}
+
+// A single rule of an Azure RBAC role management policy. The fields populated depend on the RuleType.
+// For more detail see https://learn.microsoft.com/en-us/rest/api/authorization/role-management-policies/get?view=rest-authorization-2020-10-01#rolemanagementpolicyrule
+type RoleManagementPolicyRule struct {
+	// The ID of the rule. E.g. Approval_EndUser_Assignment
+	Id string `json:"id,omitempty"`
+
+	// The type of rule. E.g. RoleManagementPolicyApprovalRule, RoleManagementPolicyEnablementRule
+	RuleType string `json:"ruleType,omitempty"`
+
+	// The target of the current rule.
+	Target RoleManagementPolicyRuleTarget `json:"target,omitempty"`
+
+	// The list of enabled rules. Populated for RoleManagementPolicyEnablementRule.
+	EnabledRules []string `json:"enabledRules,omitempty"`
+
+	// Whether the rule is enabled. Populated for RoleManagementPolicyAuthenticationContextRule.
+	IsEnabled bool `json:"isEnabled,omitempty"`
+
+	// The claim value. Populated for RoleManagementPolicyAuthenticationContextRule.
+	ClaimValue string `json:"claimValue,omitempty"`
+
+	// Whether expiration is required. Populated for RoleManagementPolicyExpirationRule.
+	IsExpirationRequired bool `json:"isExpirationRequired,omitempty"`
+
+	// The maximum duration of expiration in timespan. Populated for RoleManagementPolicyExpirationRule.
+	MaximumDuration string `json:"maximumDuration,omitempty"`
+
+	// The approval setting. Populated for RoleManagementPolicyApprovalRule.
+	Setting *RoleManagementPolicyApprovalSettings `json:"setting,omitempty"`
+}
+
+type RoleManagementPolicyApprovalSettings struct {
+	// Determines whether approval is required or not.
+	IsApprovalRequired bool `json:"isApprovalRequired,omitempty"`
+
+	// The type of rule. E.g. SingleStage, Serial, Parallel
+	ApprovalMode string `json:"approvalMode,omitempty"`
+
+	// The approval stages of the request.
+	ApprovalStages []RoleManagementPolicyApprovalStage `json:"approvalStages,omitempty"`
+}
+
+type RoleManagementPolicyApprovalStage struct {
+	// The primary approvers of the request.
+	PrimaryApprovers []RoleManagementPolicyUserSet `json:"primaryApprovers,omitempty"`
+
+	// The escalation approvers of the request.
+	EscalationApprovers []RoleManagementPolicyUserSet `json:"escalationApprovers,omitempty"`
+}
+
+type RoleManagementPolicyUserSet struct {
+	// The object id of the user or group.
+	Id string `json:"id,omitempty"`
+
+	// The type of user. E.g. User, Group
+	UserType string `json:"userType,omitempty"`
+
+	// The description of the user.
+	Description string `json:"description,omitempty"`
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents a Microsoft Entra role with an administrative unit scope.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/scopedrolemembership?view=graph-rest-1.0
+type ScopedRoleMembership struct {
+	Entity
+
+	// Unique identifier for the administrative unit that the directory role is scoped to.
+	AdministrativeUnitId string `json:"administrativeUnitId,omitempty"`
+
+	// Unique identifier for the directory role that the member is in.
+	// Note: this is the id of the activated directory role, not the role definition or template id.
+	RoleId string `json:"roleId,omitempty"`
+
+	// The principal holding the role.
+	RoleMemberInfo Identity `json:"roleMemberInfo,omitempty"`
+}
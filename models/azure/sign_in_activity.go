@@ -0,0 +1,64 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents the most recent sign-ins of a user or application.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/signinactivity?view=graph-rest-1.0
+type SignInActivity struct {
+	// The last interactive sign-in date and time, successful or not.
+	LastSignInDateTime string `json:"lastSignInDateTime,omitempty"`
+
+	// The request ID for the last interactive sign-in.
+	LastSignInRequestId string `json:"lastSignInRequestId,omitempty"`
+
+	// The last non-interactive sign-in date and time, successful or not.
+	LastNonInteractiveSignInDateTime string `json:"lastNonInteractiveSignInDateTime,omitempty"`
+
+	// The request ID for the last non-interactive sign-in.
+	LastNonInteractiveSignInRequestId string `json:"lastNonInteractiveSignInRequestId,omitempty"`
+
+	// The date and time of the last successful sign-in, interactive or not.
+	LastSuccessfulSignInDateTime string `json:"lastSuccessfulSignInDateTime,omitempty"`
+
+	// The request ID of the last successful sign-in.
+	LastSuccessfulSignInRequestId string `json:"lastSuccessfulSignInRequestId,omitempty"`
+}
+
+// Represents the most recent sign-ins of an application, both as a client and as a resource.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/serviceprincipalsigninactivity?view=graph-rest-beta
+type ServicePrincipalSignInActivity struct {
+	Entity
+
+	// The application ID of the service principal.
+	AppId string `json:"appId,omitempty"`
+
+	// The most recent sign-in of the application in any role.
+	LastSignInActivity *SignInActivity `json:"lastSignInActivity,omitempty"`
+
+	// The most recent sign-in where the application acted as a client on behalf of a user.
+	DelegatedClientSignInActivity *SignInActivity `json:"delegatedClientSignInActivity,omitempty"`
+
+	// The most recent sign-in where the application was accessed as a resource on behalf of a user.
+	DelegatedResourceSignInActivity *SignInActivity `json:"delegatedResourceSignInActivity,omitempty"`
+
+	// The most recent sign-in where the application authenticated as itself as a client.
+	ApplicationAuthenticationClientSignInActivity *SignInActivity `json:"applicationAuthenticationClientSignInActivity,omitempty"`
+
+	// The most recent sign-in where the application was accessed as a resource by an application authenticating as itself.
+	ApplicationAuthenticationResourceSignInActivity *SignInActivity `json:"applicationAuthenticationResourceSignInActivity,omitempty"`
+}
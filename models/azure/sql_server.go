@@ -0,0 +1,101 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// SqlServer https://learn.microsoft.com/en-us/rest/api/sql/servers/list?view=rest-sql-2021-11-01#server
+type SqlServer struct {
+	Entity
+
+	Identity   ManagedIdentity     `json:"identity,omitempty"`
+	Kind       string              `json:"kind,omitempty"`
+	Location   string              `json:"location,omitempty"`
+	Name       string              `json:"name,omitempty"`
+	Properties SqlServerProperties `json:"properties,omitempty"`
+	Tags       map[string]string   `json:"tags,omitempty"`
+	Type       string              `json:"type,omitempty"`
+}
+
+type SqlServerProperties struct {
+	// The login name of the SQL administrator. Its password is never returned.
+	AdministratorLogin string `json:"administratorLogin,omitempty"`
+
+	// The Entra administrator, only returned when administrators are expanded
+	Administrators SqlServerExternalAdministrator `json:"administrators,omitempty"`
+
+	FullyQualifiedDomainName string `json:"fullyQualifiedDomainName,omitempty"`
+	MinimalTlsVersion        string `json:"minimalTlsVersion,omitempty"`
+
+	// The user-assigned identity the server uses to access Entra, e.g. to resolve the Entra administrator
+	PrimaryUserAssignedIdentityId string `json:"primaryUserAssignedIdentityId,omitempty"`
+
+	PublicNetworkAccess           string `json:"publicNetworkAccess,omitempty"`
+	RestrictOutboundNetworkAccess string `json:"restrictOutboundNetworkAccess,omitempty"`
+	State                         string `json:"state,omitempty"`
+	Version                       string `json:"version,omitempty"`
+}
+
+type SqlServerExternalAdministrator struct {
+	AdministratorType string `json:"administratorType,omitempty"`
+
+	// Whether SQL authentication is disabled so that only Entra principals can sign in
+	AzureADOnlyAuthentication bool `json:"azureADOnlyAuthentication,omitempty"`
+
+	Login string `json:"login,omitempty"`
+
+	// User, Group or Application
+	PrincipalType string `json:"principalType,omitempty"`
+
+	// The object id of the administrator, or the client id of an application
+	Sid      string `json:"sid,omitempty"`
+	TenantId string `json:"tenantId,omitempty"`
+}
+
+// SqlServerFirewallRule https://learn.microsoft.com/en-us/rest/api/sql/firewall-rules/list-by-server?view=rest-sql-2021-11-01#firewallrule
+type SqlServerFirewallRule struct {
+	Entity
+
+	Name       string                          `json:"name,omitempty"`
+	Properties SqlServerFirewallRuleProperties `json:"properties,omitempty"`
+	Type       string                          `json:"type,omitempty"`
+}
+
+// A rule from 0.0.0.0 to 0.0.0.0 allows access from all Azure services, including those of other tenants
+type SqlServerFirewallRuleProperties struct {
+	EndIpAddress   string `json:"endIpAddress,omitempty"`
+	StartIpAddress string `json:"startIpAddress,omitempty"`
+}
+
+func (s SqlServer) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s SqlServer) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
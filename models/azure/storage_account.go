@@ -17,7 +17,11 @@
 
 package azure
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/bloodhoundad/azurehound/v2/enums"
+)
 
 type StorageAccount struct {
 	Entity
@@ -50,3 +54,20 @@ func (s StorageAccount) ResourceGroupId() string {
 		return ""
 	}
 }
+
+// PublicNetworkAccessEnabled reports whether the account's endpoints accept traffic from public networks. An account
+// that doesn't disable public network access is reachable from the internet unless its firewall denies by default.
+func (s StorageAccount) PublicNetworkAccessEnabled() bool {
+	return s.Properties.PublicNetworkAccess != enums.Disabled && s.Properties.NetworkAcls.DefaultAction != enums.NetworkActionDeny
+}
+
+// SharedKeyAccessEnabled reports whether requests may be authorized with the account access keys. The service treats
+// an unset allowSharedKeyAccess as true.
+func (s StorageAccount) SharedKeyAccessEnabled() bool {
+	return s.Properties.AllowSharedKeyAccess == nil || *s.Properties.AllowSharedKeyAccess
+}
+
+// AnonymousBlobAccessAllowed reports whether containers in the account may be configured for anonymous read access
+func (s StorageAccount) AnonymousBlobAccessAllowed() bool {
+	return s.Properties.AllowBlobPublicAccess
+}
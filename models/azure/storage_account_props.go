@@ -23,7 +23,7 @@ type StorageAccountProperties struct {
 	AccessTier                            enums.StorageAccountAccessTier        `json:"accessTier,omitempty"`
 	AllowBlobPublicAccess                 bool                                  `json:"allowBlobPublicAccess,omitempty"`
 	AllowCrossTenantReplication           bool                                  `json:"allowCrossTenantReplication,omitempty"`
-	AllowSharedKeyAccess                  bool                                  `json:"allowSharedKeyAccess,omitempty"`
+	AllowSharedKeyAccess                  *bool                                 `json:"allowSharedKeyAccess,omitempty"`
 	AllowedCopyScope                      enums.AllowedCopyScope                `json:"allowedCopyScope,omitempty"`
 	AzureFilesIdentityBasedAuthentication AzureFilesIdentityBasedAuthentication `json:"azureFilesIdentityBasedAuthentication,omitempty"`
 	BlobRestoreStatus                     BlobRestoreStatus                     `json:"blobRestoreStatus,omitempty"`
@@ -49,7 +49,7 @@ type StorageAccountProperties struct {
 	PrimaryLocation                       string                                `json:"primaryLocation,omitempty"`
 	PrivateEndpointConnections            []PrivateEndpointConnection           `json:"privateEndpointConnections"`
 	ProvisioningState                     enums.ProvisioningState               `json:"provisioningState,omitempty"`
-	PublicNetworkAccess                   enums.GenericEnabledDisabled          `json:"availabilitySet,omitempty"`
+	PublicNetworkAccess                   enums.GenericEnabledDisabled          `json:"publicNetworkAccess,omitempty"`
 	RoutingPreference                     RoutingPreference                     `json:"routingPreference,omitempty"`
 	SasPolicy                             SasPolicy                             `json:"sasPolicy,omitempty"`
 	SecondaryEndpoints                    Endpoints                             `json:"secondaryEndpoints,omitempty"`
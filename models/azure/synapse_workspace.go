@@ -0,0 +1,80 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// SynapseWorkspace https://learn.microsoft.com/en-us/rest/api/synapse/workspaces/list?view=rest-synapse-2021-06-01#workspace
+type SynapseWorkspace struct {
+	Entity
+
+	Identity   ManagedIdentity            `json:"identity,omitempty"`
+	Location   string                     `json:"location,omitempty"`
+	Name       string                     `json:"name,omitempty"`
+	Properties SynapseWorkspaceProperties `json:"properties,omitempty"`
+	Tags       map[string]string          `json:"tags,omitempty"`
+	Type       string                     `json:"type,omitempty"`
+}
+
+type SynapseWorkspaceProperties struct {
+	// Whether SQL authentication is disabled so that only Entra principals can sign in to the SQL pools
+	AzureADOnlyAuthentication bool `json:"azureADOnlyAuthentication,omitempty"`
+
+	ConnectivityEndpoints map[string]string `json:"connectivityEndpoints,omitempty"`
+
+	// The Entra principal initially made a Synapse Administrator of the workspace
+	CspWorkspaceAdminProperties SynapseWorkspaceAdminProperties `json:"cspWorkspaceAdminProperties,omitempty"`
+
+	DefaultDataLakeStorage      SynapseDataLakeStorageAccountDetails `json:"defaultDataLakeStorage,omitempty"`
+	ManagedResourceGroupName    string                               `json:"managedResourceGroupName,omitempty"`
+	ManagedVirtualNetwork       string                               `json:"managedVirtualNetwork,omitempty"`
+	ProvisioningState           string                               `json:"provisioningState,omitempty"`
+	PublicNetworkAccess         string                               `json:"publicNetworkAccess,omitempty"`
+	SqlAdministratorLogin       string                               `json:"sqlAdministratorLogin,omitempty"`
+	TrustedServiceBypassEnabled bool                                 `json:"trustedServiceBypassEnabled,omitempty"`
+	WorkspaceUID                string                               `json:"workspaceUID,omitempty"`
+}
+
+type SynapseWorkspaceAdminProperties struct {
+	InitialWorkspaceAdminObjectId string `json:"initialWorkspaceAdminObjectId,omitempty"`
+}
+
+type SynapseDataLakeStorageAccountDetails struct {
+	AccountUrl                   string `json:"accountUrl,omitempty"`
+	Filesystem                   string `json:"filesystem,omitempty"`
+	ResourceId                   string `json:"resourceId,omitempty"`
+	CreateManagedPrivateEndpoint bool   `json:"createManagedPrivateEndpoint,omitempty"`
+}
+
+func (s SynapseWorkspace) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s SynapseWorkspace) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
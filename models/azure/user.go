@@ -424,6 +424,12 @@ type User struct {
 	// Returned only on $select.
 	SignInSessionsValidFromDateTime string `json:"signInSessionsValidFromDateTime,omitempty"`
 
+	// The last interactive and non-interactive sign-ins of the user.
+	// Read-only.
+	// Returned only on $select.
+	// Requires the AuditLog.Read.All permission and a Microsoft Entra ID P1 or P2 license.
+	SignInActivity *SignInActivity `json:"signInActivity,omitempty"`
+
 	// The state or province in the user's address.
 	// Maximum length is 128 characters.
 	// Returned only on $select.
@@ -0,0 +1,63 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+// Mapped according to https://learn.microsoft.com/en-us/rest/api/managedidentity/user-assigned-identities/list-by-subscription?view=rest-managedidentity-2023-01-31
+type UserAssignedManagedIdentity struct {
+	Entity
+
+	Location   string                                `json:"location,omitempty"`
+	Name       string                                `json:"name,omitempty"`
+	Properties UserAssignedManagedIdentityProperties `json:"properties,omitempty"`
+	Tags       map[string]string                     `json:"tags,omitempty"`
+	Type       string                                `json:"type,omitempty"`
+}
+
+type UserAssignedManagedIdentityProperties struct {
+	// The id of the app associated with the identity.
+	ClientId string `json:"clientId,omitempty"`
+
+	// The id of the service principal object associated with the created identity.
+	PrincipalId string `json:"principalId,omitempty"`
+
+	// The id of the tenant which the identity belongs to.
+	TenantId string `json:"tenantId,omitempty"`
+
+	// Enum to configure regional restrictions on identity assignment, as necessary.
+	IsolationScope string `json:"isolationScope,omitempty"`
+}
+
+func (s UserAssignedManagedIdentity) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s UserAssignedManagedIdentity) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+// Represents the authentication methods a user has registered and what they are capable of, as reported by
+// the authentication methods activity report.
+// For more detail see https://learn.microsoft.com/en-us/graph/api/resources/userregistrationdetails?view=graph-rest-1.0
+type UserRegistrationDetails struct {
+	Entity
+
+	// The user principal name.
+	UserPrincipalName string `json:"userPrincipalName,omitempty"`
+
+	// The user display name.
+	UserDisplayName string `json:"userDisplayName,omitempty"`
+
+	// Identifies whether the user is a member or guest in the tenant.
+	UserType string `json:"userType,omitempty"`
+
+	// Indicates whether the user has an admin role in the tenant.
+	IsAdmin bool `json:"isAdmin"`
+
+	// Indicates whether the user has registered a strong authentication method for multifactor authentication.
+	IsMfaRegistered bool `json:"isMfaRegistered"`
+
+	// Indicates whether the user has registered a strong authentication method and is enabled to use it.
+	IsMfaCapable bool `json:"isMfaCapable"`
+
+	// Indicates whether the user has registered a passwordless method and is enabled to use it.
+	IsPasswordlessCapable bool `json:"isPasswordlessCapable"`
+
+	// Indicates whether the user has registered the required number of methods for self-service password reset.
+	IsSsprRegistered bool `json:"isSsprRegistered"`
+
+	// Indicates whether the user is allowed to perform self-service password reset by policy.
+	IsSsprEnabled bool `json:"isSsprEnabled"`
+
+	// Indicates whether the user is registered and enabled for self-service password reset.
+	IsSsprCapable bool `json:"isSsprCapable"`
+
+	// The methods the user has registered, e.g. mobilePhone, fido2SecurityKey or temporaryAccessPass.
+	MethodsRegistered []string `json:"methodsRegistered,omitempty"`
+
+	// Indicates whether system preferred authentication method is enabled.
+	IsSystemPreferredAuthenticationMethodEnabled bool `json:"isSystemPreferredAuthenticationMethodEnabled"`
+
+	// The system preferred methods, used when system preferred authentication is enabled.
+	SystemPreferredAuthenticationMethods []string `json:"systemPreferredAuthenticationMethods,omitempty"`
+
+	// The method the user selected as the default second-factor for performing multifactor authentication.
+	UserPreferredMethodForSecondaryAuthentication string `json:"userPreferredMethodForSecondaryAuthentication,omitempty"`
+
+	// The date and time the record was last updated.
+	LastUpdatedDateTime string `json:"lastUpdatedDateTime,omitempty"`
+}
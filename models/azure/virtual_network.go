@@ -0,0 +1,74 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package azure
+
+import "strings"
+
+type VirtualNetwork struct {
+	Entity
+
+	Location   string                   `json:"location,omitempty"`
+	Name       string                   `json:"name,omitempty"`
+	Properties VirtualNetworkProperties `json:"properties,omitempty"`
+	Tags       map[string]string        `json:"tags,omitempty"`
+	Type       string                   `json:"type,omitempty"`
+}
+
+type VirtualNetworkProperties struct {
+	AddressSpace      VirtualNetworkAddressSpace `json:"addressSpace,omitempty"`
+	ProvisioningState string                     `json:"provisioningState,omitempty"`
+	Subnets           []Subnet                   `json:"subnets,omitempty"`
+}
+
+type VirtualNetworkAddressSpace struct {
+	AddressPrefixes []string `json:"addressPrefixes,omitempty"`
+}
+
+type Subnet struct {
+	Id         string           `json:"id,omitempty"`
+	Name       string           `json:"name,omitempty"`
+	Properties SubnetProperties `json:"properties,omitempty"`
+}
+
+type SubnetProperties struct {
+	AddressPrefix   string   `json:"addressPrefix,omitempty"`
+	AddressPrefixes []string `json:"addressPrefixes,omitempty"`
+
+	// Whether virtual machines in the subnet get a default outbound address. Does not affect inbound exposure.
+	DefaultOutboundAccess *bool `json:"defaultOutboundAccess,omitempty"`
+
+	NetworkSecurityGroup SubResource `json:"networkSecurityGroup,omitempty"`
+}
+
+func (s VirtualNetwork) ResourceGroupName() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 4 {
+		return parts[4]
+	} else {
+		return ""
+	}
+}
+
+func (s VirtualNetwork) ResourceGroupId() string {
+	parts := strings.Split(s.Id, "/")
+	if len(parts) > 5 {
+		return strings.Join(parts[:5], "/")
+	} else {
+		return ""
+	}
+}
@@ -22,14 +22,26 @@ import "strings"
 type VMScaleSet struct {
 	Entity
 
-	ExtendedLocation ExtendedLocation  `json:"extendedLocation,omitempty"`
-	Identity         ManagedIdentity   `json:"identity,omitempty"`
-	Location         string            `json:"location,omitempty"`
-	Name             string            `json:"name,omitempty"`
-	Plan             Plan              `json:"plan,omitempty"`
-	Tags             map[string]string `json:"tags,omitempty"`
-	Type             string            `json:"type,omitempty"`
-	Zones            []string          `json:"zones,omitempty"`
+	ExtendedLocation ExtendedLocation     `json:"extendedLocation,omitempty"`
+	Identity         ManagedIdentity      `json:"identity,omitempty"`
+	Location         string               `json:"location,omitempty"`
+	Name             string               `json:"name,omitempty"`
+	Plan             Plan                 `json:"plan,omitempty"`
+	Properties       VMScaleSetProperties `json:"properties,omitempty"`
+	Tags             map[string]string    `json:"tags,omitempty"`
+	Type             string               `json:"type,omitempty"`
+	Zones            []string             `json:"zones,omitempty"`
+}
+
+type VMScaleSetProperties struct {
+	OrchestrationMode     string              `json:"orchestrationMode,omitempty"`
+	ProvisioningState     string              `json:"provisioningState,omitempty"`
+	VirtualMachineProfile VMScaleSetVMProfile `json:"virtualMachineProfile,omitempty"`
+}
+
+// VMScaleSetVMProfile is the template instances are created from. Only the network profile is mapped.
+type VMScaleSetVMProfile struct {
+	NetworkProfile NetworkProfile `json:"networkProfile,omitempty"`
 }
 
 func (s VMScaleSet) ResourceGroupName() string {
@@ -0,0 +1,45 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import "github.com/bloodhoundad/azurehound/v2/models/azure"
+
+type ConditionalAccessPolicy struct {
+	azure.ConditionalAccessPolicy
+	TenantId   string `json:"tenantId"`
+	TenantName string `json:"tenantName"`
+
+	// The conditions' user, group, role and application references resolved to directory object IDs.
+	// Role template IDs are resolved to role definition object IDs and application client IDs (appId) are
+	// resolved to service principal object IDs.
+	IncludedUsers  []string `json:"includedUsers"`
+	ExcludedUsers  []string `json:"excludedUsers"`
+	IncludedGroups []string `json:"includedGroups"`
+	ExcludedGroups []string `json:"excludedGroups"`
+	IncludedRoles  []string `json:"includedRoles"`
+	ExcludedRoles  []string `json:"excludedRoles"`
+	IncludedApps   []string `json:"includedApps"`
+	ExcludedApps   []string `json:"excludedApps"`
+
+	// Keyword values that do not reference a single directory object (e.g. All, None, GuestsOrExternalUsers,
+	// Office365, MicrosoftAdminPortals).
+	IncludedUserKeywords []string `json:"includedUserKeywords"`
+	ExcludedUserKeywords []string `json:"excludedUserKeywords"`
+	IncludedAppKeywords  []string `json:"includedAppKeywords"`
+	ExcludedAppKeywords  []string `json:"excludedAppKeywords"`
+}
@@ -0,0 +1,32 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import "github.com/bloodhoundad/azurehound/v2/models/azure"
+
+type GroupEligibilityScheduleInstances struct {
+	EligibilityScheduleInstances []azure.PrivilegedAccessGroupEligibilityScheduleInstance `json:"eligibilityScheduleInstances"`
+	GroupId                      string                                                   `json:"groupId"`
+	TenantId                     string                                                   `json:"tenantId"`
+}
+
+type GroupAssignmentScheduleInstances struct {
+	AssignmentScheduleInstances []azure.PrivilegedAccessGroupAssignmentScheduleInstance `json:"assignmentScheduleInstances"`
+	GroupId                     string                                                  `json:"groupId"`
+	TenantId                    string                                                  `json:"tenantId"`
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"encoding/json"
+)
+
+type GroupTransitiveMember struct {
+	Member  json.RawMessage `json:"member"`
+	GroupId string          `json:"groupId"`
+
+	// The number of memberships between the group and the member: 1 for direct members, 2 for members of a
+	// directly nested group and so on. Nil when the path to the member could not be determined.
+	Depth *int `json:"depth"`
+}
+
+func (s *GroupTransitiveMember) MarshalJSON() ([]byte, error) {
+	output := make(map[string]any)
+	output["groupId"] = s.GroupId
+	output["depth"] = s.Depth
+
+	if member, err := OmitEmpty(s.Member); err != nil {
+		return nil, err
+	} else {
+		output["member"] = member
+		return json.Marshal(output)
+	}
+}
+
+type GroupTransitiveMembers struct {
+	Members []GroupTransitiveMember `json:"members"`
+	GroupId string                  `json:"groupId"`
+}
+
+// UserTransitiveMemberOf holds every group, directory role and administrative unit a user belongs to, directly or
+// through nested groups.
+type UserTransitiveMemberOf struct {
+	MemberOf []json.RawMessage `json:"memberOf"`
+	UserId   string            `json:"userId"`
+}
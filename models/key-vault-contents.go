@@ -0,0 +1,39 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+// KeyVaultContents inventories the secrets, keys and certificates stored in a key vault so that vaults can be
+// prioritised by what they hold. Values are never collected.
+type KeyVaultContents struct {
+	KeyVaultId   string         `json:"keyVaultId"`
+	Certificates []KeyVaultItem `json:"certificates"`
+	Keys         []KeyVaultItem `json:"keys"`
+	Secrets      []KeyVaultItem `json:"secrets"`
+	TenantId     string         `json:"tenantId"`
+}
+
+// KeyVaultItem is the metadata of a single secret, key or certificate. Times are in UTC seconds since the unix epoch.
+type KeyVaultItem struct {
+	Name        string            `json:"name"`
+	ContentType string            `json:"contentType,omitempty"`
+	Enabled     bool              `json:"enabled"`
+	Expires     int64             `json:"expires,omitempty"`
+	Managed     bool              `json:"managed,omitempty"`
+	NotBefore   int64             `json:"notBefore,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
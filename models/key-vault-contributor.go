@@ -28,3 +28,11 @@ type KeyVaultContributors struct {
 	Contributors []KeyVaultContributor `json:"contributors"`
 	KeyVaultId   string                `json:"keyVaultId"`
 }
+
+func (s KeyVaultContributors) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Contributors))
+	for _, item := range s.Contributors {
+		ids = append(ids, item.Contributor.Properties.PrincipalId)
+	}
+	return ids
+}
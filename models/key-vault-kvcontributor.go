@@ -28,3 +28,11 @@ type KeyVaultKVContributors struct {
 	KVContributors []KeyVaultKVContributor `json:"kvContributors"`
 	KeyVaultId     string                  `json:"keyVaultId"`
 }
+
+func (s KeyVaultKVContributors) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.KVContributors))
+	for _, item := range s.KVContributors {
+		ids = append(ids, item.KVContributor.Properties.PrincipalId)
+	}
+	return ids
+}
@@ -28,3 +28,11 @@ type KeyVaultOwners struct {
 	Owners     []KeyVaultOwner `json:"owners"`
 	KeyVaultId string          `json:"keyVaultId"`
 }
+
+func (s KeyVaultOwners) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Owners))
+	for _, item := range s.Owners {
+		ids = append(ids, item.Owner.Properties.PrincipalId)
+	}
+	return ids
+}
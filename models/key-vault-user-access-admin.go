@@ -28,3 +28,11 @@ type KeyVaultUserAccessAdmins struct {
 	UserAccessAdmins []KeyVaultUserAccessAdmin `json:"userAccessAdmins"`
 	KeyVaultId       string                    `json:"keyVaultId"`
 }
+
+func (s KeyVaultUserAccessAdmins) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.UserAccessAdmins))
+	for _, item := range s.UserAccessAdmins {
+		ids = append(ids, item.UserAccessAdmin.Properties.PrincipalId)
+	}
+	return ids
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2026 Specter Ops, Inc.
+//
+// This file is part of AzureHound.
+//
+// AzureHound is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// AzureHound is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+// LighthouseDelegation is an Azure Lighthouse registration assignment flattened to the principals of the managing
+// tenant and the roles they hold on the delegated subscription or resource group.
+type LighthouseDelegation struct {
+	AssignmentId               string                         `json:"assignmentId"`
+	Scope                      string                         `json:"scope"`
+	RegistrationDefinitionId   string                         `json:"registrationDefinitionId"`
+	RegistrationDefinitionName string                         `json:"registrationDefinitionName"`
+	ManagedByTenantId          string                         `json:"managedByTenantId"`
+	ManagedByTenantName        string                         `json:"managedByTenantName"`
+	Principals                 []LighthouseDelegatedPrincipal `json:"principals"`
+	TenantId                   string                         `json:"tenantId"`
+}
+
+type LighthouseDelegatedPrincipal struct {
+	PrincipalId          string `json:"principalId"`
+	PrincipalDisplayName string `json:"principalDisplayName"`
+
+	// The tenant the principal belongs to, which is always the managing tenant
+	PrincipalTenantId string `json:"principalTenantId"`
+
+	// Whether the principal belongs to a tenant other than the one being collected
+	Foreign bool `json:"foreign"`
+
+	RoleDefinitionId           string   `json:"roleDefinitionId"`
+	DelegatedRoleDefinitionIds []string `json:"delegatedRoleDefinitionIds,omitempty"`
+
+	// Whether the role must be activated just in time rather than being permanently held
+	Eligible bool `json:"eligible"`
+}
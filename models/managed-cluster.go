@@ -17,11 +17,55 @@
 
 package models
 
-import "github.com/bloodhoundad/azurehound/v2/models/azure"
+import (
+	"github.com/bloodhoundad/azurehound/v2/enums"
+	"github.com/bloodhoundad/azurehound/v2/models/azure"
+)
 
 type ManagedCluster struct {
 	azure.ManagedCluster
 	SubscriptionId  string `json:"subscriptionId"`
 	ResourceGroupId string `json:"resourceGroupId"`
 	TenantId        string `json:"tenantId"`
+
+	// Access configuration
+	LocalAccountsDisabled bool     `json:"localAccountsDisabled"`
+	EntraIntegrated       bool     `json:"entraIntegrated"`
+	AzureRBACEnabled      bool     `json:"azureRBACEnabled"`
+	PublicAPIServer       bool     `json:"publicAPIServer"`
+	AuthorizedIPRanges    []string `json:"authorizedIPRanges"`
+}
+
+// ManagedClusterIdentity relates a managed cluster to an identity it authenticates to Azure as
+type ManagedClusterIdentity struct {
+	ManagedClusterId string                          `json:"managedClusterId"`
+	Use              enums.ManagedClusterIdentityUse `json:"use"`
+	// The add-on the identity belongs to when used by an add-on
+	AddonName string `json:"addonName,omitempty"`
+	// The resource id of a user-assigned identity, empty for a system-assigned control plane identity
+	IdentityId  string `json:"identityId,omitempty"`
+	ClientId    string `json:"clientId,omitempty"`
+	PrincipalId string `json:"principalId"`
+	TenantId    string `json:"tenantId"`
+}
+
+type ManagedClusterAdmin struct {
+	Admin azure.RoleAssignment `json:"admin"`
+	// The id of the built-in Azure Kubernetes Service Cluster Admin or RBAC Cluster Admin role the assignment is
+	// equivalent to
+	BuiltInRoleId    string `json:"builtInRoleId"`
+	ManagedClusterId string `json:"managedClusterId"`
+}
+
+type ManagedClusterAdmins struct {
+	Admins           []ManagedClusterAdmin `json:"admins"`
+	ManagedClusterId string                `json:"managedClusterId"`
+}
+
+func (s ManagedClusterAdmins) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Admins))
+	for _, item := range s.Admins {
+		ids = append(ids, item.Admin.Properties.PrincipalId)
+	}
+	return ids
 }
@@ -30,3 +30,11 @@ type ManagementGroupOwners struct {
 	Owners            []ManagementGroupOwner `json:"owners"`
 	ManagementGroupId string                 `json:"managementGroupId"`
 }
+
+func (s ManagementGroupOwners) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Owners))
+	for _, item := range s.Owners {
+		ids = append(ids, item.Owner.Properties.PrincipalId)
+	}
+	return ids
+}
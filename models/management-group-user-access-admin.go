@@ -30,3 +30,11 @@ type ManagementGroupUserAccessAdmins struct {
 	UserAccessAdmins  []ManagementGroupUserAccessAdmin `json:"userAccessAdmins"`
 	ManagementGroupId string                           `json:"managementGroupId"`
 }
+
+func (s ManagementGroupUserAccessAdmins) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.UserAccessAdmins))
+	for _, item := range s.UserAccessAdmins {
+		ids = append(ids, item.UserAccessAdmin.Properties.PrincipalId)
+	}
+	return ids
+}
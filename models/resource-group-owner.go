@@ -30,3 +30,11 @@ type ResourceGroupOwners struct {
 	Owners          []ResourceGroupOwner `json:"owners"`
 	ResourceGroupId string               `json:"resourceGroupId"`
 }
+
+func (s ResourceGroupOwners) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Owners))
+	for _, item := range s.Owners {
+		ids = append(ids, item.Owner.Properties.PrincipalId)
+	}
+	return ids
+}
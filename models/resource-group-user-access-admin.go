@@ -30,3 +30,11 @@ type ResourceGroupUserAccessAdmins struct {
 	UserAccessAdmins []ResourceGroupUserAccessAdmin `json:"userAccessAdmins"`
 	ResourceGroupId  string                         `json:"resourceGroupId"`
 }
+
+func (s ResourceGroupUserAccessAdmins) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.UserAccessAdmins))
+	for _, item := range s.UserAccessAdmins {
+		ids = append(ids, item.UserAccessAdmin.Properties.PrincipalId)
+	}
+	return ids
+}
@@ -21,8 +21,22 @@ import (
 	"github.com/bloodhoundad/azurehound/v2/models/azure"
 )
 
+// RoleAssignmentHolder is implemented by the records that grant directory or Azure roles, whether assigned or made
+// eligible through PIM. Records opt in explicitly so that only role grants mark their principals as privileged.
+type RoleAssignmentHolder interface {
+	AssignedPrincipalIds() []string
+}
+
 type RoleAssignments struct {
 	RoleAssignments  []azure.UnifiedRoleAssignment `json:"roleAssignments"`
 	RoleDefinitionId string                        `json:"roleDefinitionId"`
 	TenantId         string                        `json:"tenantId"`
 }
+
+func (s RoleAssignments) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.RoleAssignments))
+	for _, assignment := range s.RoleAssignments {
+		ids = append(ids, assignment.PrincipalId)
+	}
+	return ids
+}
@@ -25,3 +25,7 @@ type RoleEligibilityScheduleInstance struct {
 	StartDateTime    string `json:"startDateTime,omitempty"`
 	TenantId         string `json:"tenantId,omitempty"`
 }
+
+func (s RoleEligibilityScheduleInstance) AssignedPrincipalIds() []string {
+	return []string{s.PrincipalId}
+}
@@ -21,6 +21,7 @@ import "github.com/bloodhoundad/azurehound/v2/models/azure"
 
 type ServicePrincipal struct {
 	azure.ServicePrincipal
-	TenantId   string `json:"tenantId"`
-	TenantName string `json:"tenantName"`
+	SignInActivity *azure.ServicePrincipalSignInActivity `json:"signInActivity,omitempty"`
+	TenantId       string                                `json:"tenantId"`
+	TenantName     string                                `json:"tenantName"`
 }
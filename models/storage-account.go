@@ -25,4 +25,9 @@ type StorageAccount struct {
 	ResourceGroupId   string `json:"resourceGroupId"`
 	ResourceGroupName string `json:"resourceGroupName"`
 	TenantId          string `json:"tenantId"`
+
+	// Network exposure, with the service defaults applied to settings the API leaves unset
+	PublicNetworkAccess bool `json:"publicNetworkAccess"`
+	SharedKeyAccess     bool `json:"sharedKeyAccess"`
+	AnonymousBlobAccess bool `json:"anonymousBlobAccess"`
 }
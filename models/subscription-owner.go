@@ -28,3 +28,11 @@ type SubscriptionOwners struct {
 	Owners         []SubscriptionOwner `json:"owners"`
 	SubscriptionId string              `json:"subscriptionId"`
 }
+
+func (s SubscriptionOwners) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Owners))
+	for _, item := range s.Owners {
+		ids = append(ids, item.Owner.Properties.PrincipalId)
+	}
+	return ids
+}
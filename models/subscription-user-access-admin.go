@@ -28,3 +28,11 @@ type SubscriptionUserAccessAdmins struct {
 	UserAccessAdmins []SubscriptionUserAccessAdmin `json:"userAccessAdmins"`
 	SubscriptionId   string                        `json:"subscriptionId"`
 }
+
+func (s SubscriptionUserAccessAdmins) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.UserAccessAdmins))
+	for _, item := range s.UserAccessAdmins {
+		ids = append(ids, item.UserAccessAdmin.Properties.PrincipalId)
+	}
+	return ids
+}
@@ -28,3 +28,11 @@ type VirtualMachineAdminLogins struct {
 	AdminLogins      []VirtualMachineAdminLogin `json:"adminLogins"`
 	VirtualMachineId string                     `json:"virtualMachineId"`
 }
+
+func (s VirtualMachineAdminLogins) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.AdminLogins))
+	for _, item := range s.AdminLogins {
+		ids = append(ids, item.AdminLogin.Properties.PrincipalId)
+	}
+	return ids
+}
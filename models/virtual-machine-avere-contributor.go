@@ -28,3 +28,11 @@ type VirtualMachineAvereContributors struct {
 	AvereContributors []VirtualMachineAvereContributor `json:"avereContributors"`
 	VirtualMachineId  string                           `json:"virtualMachineId"`
 }
+
+func (s VirtualMachineAvereContributors) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.AvereContributors))
+	for _, item := range s.AvereContributors {
+		ids = append(ids, item.AvereContributor.Properties.PrincipalId)
+	}
+	return ids
+}
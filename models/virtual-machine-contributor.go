@@ -28,3 +28,11 @@ type VirtualMachineContributors struct {
 	Contributors     []VirtualMachineContributor `json:"contributors"`
 	VirtualMachineId string                      `json:"virtualMachineId"`
 }
+
+func (s VirtualMachineContributors) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Contributors))
+	for _, item := range s.Contributors {
+		ids = append(ids, item.Contributor.Properties.PrincipalId)
+	}
+	return ids
+}
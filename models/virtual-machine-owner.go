@@ -28,3 +28,11 @@ type VirtualMachineOwners struct {
 	Owners           []VirtualMachineOwner `json:"owners"`
 	VirtualMachineId string                `json:"virtualMachineId"`
 }
+
+func (s VirtualMachineOwners) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.Owners))
+	for _, item := range s.Owners {
+		ids = append(ids, item.Owner.Properties.PrincipalId)
+	}
+	return ids
+}
@@ -28,3 +28,11 @@ type VirtualMachineUserAccessAdmins struct {
 	UserAccessAdmins []VirtualMachineUserAccessAdmin `json:"userAccessAdmins"`
 	VirtualMachineId string                          `json:"virtualMachineId"`
 }
+
+func (s VirtualMachineUserAccessAdmins) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.UserAccessAdmins))
+	for _, item := range s.UserAccessAdmins {
+		ids = append(ids, item.UserAccessAdmin.Properties.PrincipalId)
+	}
+	return ids
+}
@@ -28,3 +28,11 @@ type VirtualMachineVMContributors struct {
 	VMContributors   []VirtualMachineVMContributor `json:"vmContributors"`
 	VirtualMachineId string                        `json:"virtualMachineId"`
 }
+
+func (s VirtualMachineVMContributors) AssignedPrincipalIds() []string {
+	ids := make([]string, 0, len(s.VMContributors))
+	for _, item := range s.VMContributors {
+		ids = append(ids, item.VMContributor.Properties.PrincipalId)
+	}
+	return ids
+}
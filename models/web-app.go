@@ -25,4 +25,9 @@ type WebApp struct {
 	ResourceGroupId   string `json:"resourceGroupId"`
 	ResourceGroupName string `json:"resourceGroupName"`
 	TenantId          string `json:"tenantId"`
+
+	// Whether the site's publishing credentials can be used for basic authentication to its SCM (Kudu) and FTP endpoints.
+	// Nil when the policies could not be read.
+	ScmBasicAuthEnabled *bool `json:"scmBasicAuthEnabled,omitempty"`
+	FtpBasicAuthEnabled *bool `json:"ftpBasicAuthEnabled,omitempty"`
 }